@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"gateway-admin/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListAuditLogs godoc
+// @Summary List audit logs
+// @Description Lists privileged changes made through the admin and product manager gateways, newest first. With format=csv every matching entry is returned as a CSV file and limit/offset are ignored. Requires the audit:read permission.
+// @Tags audit
+// @Produce json,text/csv
+// @Param actor_id query string false "Who made the change"
+// @Param action query string false "Action, such as user.ban or product.update_price"
+// @Param target_type query string false "Kind of target, such as user, role, order or product"
+// @Param target_id query string false "Target ID"
+// @Param source query string false "Gateway the change came through" Enums(admin, pm)
+// @Param from query string false "At or after, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "Before, RFC 3339 or YYYY-MM-DD"
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param offset query int false "Offset"
+// @Param format query string false "Response format" Enums(json, csv)
+// @Success 200 {object} models.ListAuditLogsResp "Audit logs"
+// @Failure 400 {object} string "Invalid filter"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /audit-logs [get]
+func (h *HTTPHandler) ListAuditLogs(c *gin.Context) {
+	req, err := listAuditLogsReq(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	csvFormat := c.Query("format") == "csv"
+	if csvFormat {
+		req.Limit, req.Offset = 0, 0
+	}
+
+	res, err := h.US.ListAuditLogs(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list audit logs": err.Error()})
+		return
+	}
+	if !csvFormat {
+		c.JSON(http.StatusOK, res)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="audit-logs.csv"`)
+	c.Header("Content-Type", "text/csv")
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"id", "created_at", "actor_id", "actor_role", "source", "action", "target_type", "target_id", "before", "after", "ip", "user_agent"})
+	for _, entry := range res.Logs {
+		w.Write([]string{
+			strconv.FormatInt(entry.ID, 10), entry.CreatedAt.Format(time.RFC3339), entry.ActorID, entry.ActorRole,
+			entry.Source, entry.Action, entry.TargetType, entry.TargetID,
+			string(entry.Before), string(entry.After), entry.IP, entry.UserAgent,
+		})
+	}
+	w.Flush()
+}
+
+func listAuditLogsReq(c *gin.Context) (*models.ListAuditLogsReq, error) {
+	req := &models.ListAuditLogsReq{
+		ActorID:    c.Query("actor_id"),
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+		Source:     c.Query("source"),
+	}
+	var err error
+	if req.ActorID != "" {
+		if _, err := uuid.Parse(req.ActorID); err != nil {
+			return nil, errors.New("actor_id must be a user ID")
+		}
+	}
+	if req.From, err = queryTime(c, "from"); err != nil {
+		return nil, err
+	}
+	if req.To, err = queryTime(c, "to"); err != nil {
+		return nil, err
+	}
+	if req.Limit, err = strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err != nil || req.Limit < 1 || req.Limit > 100 {
+		return nil, errors.New("limit must be between 1 and 100")
+	}
+	if req.Offset, err = strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err != nil || req.Offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	return req, nil
+}
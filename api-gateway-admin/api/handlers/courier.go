@@ -1,8 +1,9 @@
 package handlers
 
 import (
-	"auth-service/config"
-	"auth-service/models"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,7 +11,7 @@ import (
 
 // AddCourier godoc
 // @Summary Add a courier
-// @Description Adds a courier to the system. Only admins are allowed to use this function.
+// @Description Adds a courier to the system. Requires the staff:manage permission.
 // @Tags courier
 // @Accept json
 // @Produce json
@@ -48,17 +49,18 @@ func (h *HTTPHandler) AddCourier(c *gin.Context) {
 
 	req.Password = string(hashedPassword)
 
-	err = h.US.AddCourier(&req)
+	user, err := h.US.AddCourier(&req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't add courier": err.Error()})
 		return
 	}
+	h.Audit.Record(c, managers.AuditCourierAdded, "user", user.ID, nil, user)
 	c.JSON(http.StatusOK, gin.H{"Courier is added": req.Email})
 }
 
 // DeleteCourier godoc
 // @Summary Delete a courier
-// @Description Deletes a courier from the system. Only admins are allowed to use this function.
+// @Description Deletes a courier from the system. Requires the staff:manage permission.
 // @Tags courier
 // @Accept json
 // @Produce json
@@ -77,18 +79,20 @@ func (h *HTTPHandler) DeleteCourier(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email format"})
 			return
 		}
-		err := h.US.DeleteCourier(&models.DeleteCourierReq{Email: id_or_email})
+		user, err := h.US.DeleteCourier(&models.DeleteCourierReq{Email: id_or_email})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't delete courier": err.Error()})
 			return
 		}
+		h.Audit.Record(c, managers.AuditCourierDeleted, "user", user.ID, user, nil)
 		c.JSON(http.StatusOK, gin.H{"Courier is deleted!": id_or_email})
 	} else if data == "id" {
-		err := h.US.DeleteCourier(&models.DeleteCourierReq{ID: id_or_email})
+		user, err := h.US.DeleteCourier(&models.DeleteCourierReq{ID: id_or_email})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't delete courier": err.Error()})
 			return
 		}
+		h.Audit.Record(c, managers.AuditCourierDeleted, "user", user.ID, user, nil)
 		c.JSON(http.StatusOK, gin.H{"Courier is deleted": id_or_email})
 	}
 }
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"errors"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// ListCouriers godoc
+// @Summary List couriers
+// @Description Lists couriers with their onboarding status, those waiting for review the longest first. With online=true, lists only couriers dispatch can offer deliveries to, longest online first. Requires the courier:review permission.
+// @Tags courier
+// @Produce json
+// @Param status query string false "Onboarding status" Enums(invited, documents_submitted, approved, active, suspended)
+// @Param online query bool false "Only couriers on shift and online"
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param offset query int false "Offset"
+// @Success 200 {object} models.ListCouriersResp "Couriers"
+// @Failure 400 {object} string "Invalid filter"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers [get]
+func (h *HTTPHandler) ListCouriers(c *gin.Context) {
+	req := &models.ListCouriersReq{Status: c.Query("status")}
+	var err error
+	if req.Online, err = strconv.ParseBool(c.DefaultQuery("online", "false")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "online must be true or false"})
+		return
+	}
+	if req.Limit, err = strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err != nil || req.Limit < 1 || req.Limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+		return
+	}
+	if req.Offset, err = strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err != nil || req.Offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "offset must not be negative"})
+		return
+	}
+
+	res, err := h.US.ListCouriers(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list couriers": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCourier godoc
+// @Summary Get a courier
+// @Description Gets a courier's profile and documents. Document URLs are presigned and expire after a while. Requires the courier:review permission.
+// @Tags courier
+// @Produce json
+// @Param id path string true "Courier's user ID"
+// @Success 200 {object} models.CourierProfile "Courier"
+// @Failure 400 {object} string "Invalid user ID"
+// @Failure 404 {object} string "Courier not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/{id} [get]
+func (h *HTTPHandler) GetCourier(c *gin.Context) {
+	if err := config.IsValidUUID(c.Param("id")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	courier, err := h.US.GetCourier(c.Param("id"))
+	if err != nil {
+		c.JSON(courierStatus(err), gin.H{"Couldn't get courier": err.Error()})
+		return
+	}
+	for i, doc := range courier.Documents {
+		courier.Documents[i].URL, err = h.Documents.URL(doc.ObjectKey)
+		if err != nil {
+			log.Printf("failed to sign a link to %s: %v", doc.ObjectKey, err)
+		}
+	}
+	c.JSON(http.StatusOK, courier)
+}
+
+// ApproveCourier godoc
+// @Summary Approve a courier
+// @Description Approves a courier whose documents are submitted. They can start working once they activate their profile. Requires the courier:review permission.
+// @Tags courier
+// @Produce json
+// @Param id path string true "Courier's user ID"
+// @Success 200 {object} models.CourierProfile "Courier"
+// @Failure 404 {object} string "Courier not found"
+// @Failure 409 {object} string "Courier hasn't submitted documents"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/{id}/approve [put]
+func (h *HTTPHandler) ApproveCourier(c *gin.Context) {
+	h.reviewCourier(c, managers.ApproveCourier, managers.AuditCourierApproved, false)
+}
+
+// RejectCourier godoc
+// @Summary Reject a courier
+// @Description Sends a courier's submission back with a reason, so they can fix their profile or documents and submit again. Requires the courier:review permission.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param id path string true "Courier's user ID"
+// @Param data body models.ReviewCourierReq true "Reason"
+// @Success 200 {object} models.CourierProfile "Courier"
+// @Failure 400 {object} string "Reason is missing"
+// @Failure 404 {object} string "Courier not found"
+// @Failure 409 {object} string "Courier hasn't submitted documents"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/{id}/reject [put]
+func (h *HTTPHandler) RejectCourier(c *gin.Context) {
+	h.reviewCourier(c, managers.RejectCourier, managers.AuditCourierRejected, true)
+}
+
+// SuspendCourier godoc
+// @Summary Suspend a courier
+// @Description Stops an approved or active courier from working and ends their shift. Requires the courier:review permission.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param id path string true "Courier's user ID"
+// @Param data body models.ReviewCourierReq true "Reason"
+// @Success 200 {object} models.CourierProfile "Courier"
+// @Failure 400 {object} string "Reason is missing"
+// @Failure 404 {object} string "Courier not found"
+// @Failure 409 {object} string "Courier isn't approved or active"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/{id}/suspend [put]
+func (h *HTTPHandler) SuspendCourier(c *gin.Context) {
+	h.reviewCourier(c, managers.SuspendCourier, managers.AuditCourierSuspended, true)
+}
+
+// ReinstateCourier godoc
+// @Summary Reinstate a courier
+// @Description Lets a suspended courier work again. Requires the courier:review permission.
+// @Tags courier
+// @Produce json
+// @Param id path string true "Courier's user ID"
+// @Success 200 {object} models.CourierProfile "Courier"
+// @Failure 404 {object} string "Courier not found"
+// @Failure 409 {object} string "Courier isn't suspended"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/{id}/reinstate [put]
+func (h *HTTPHandler) ReinstateCourier(c *gin.Context) {
+	h.reviewCourier(c, managers.ReinstateCourier, managers.AuditCourierReinstated, false)
+}
+
+func (h *HTTPHandler) reviewCourier(c *gin.Context, review managers.CourierReview, action string, withBody bool) {
+	req := &models.ReviewCourierReq{}
+	if withBody {
+		if err := c.BindJSON(req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+			return
+		}
+	}
+	req.UserID = c.Param("id")
+	if err := config.IsValidUUID(req.UserID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.ReviewedBy, _ = c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+
+	before, err := h.US.GetCourier(req.UserID)
+	if err != nil {
+		c.JSON(courierStatus(err), gin.H{"Couldn't review courier": err.Error()})
+		return
+	}
+	if err := h.US.ReviewCourier(review, req); err != nil {
+		c.JSON(courierStatus(err), gin.H{"Couldn't review courier": err.Error()})
+		return
+	}
+	after, err := h.US.GetCourier(req.UserID)
+	if err != nil {
+		c.JSON(courierStatus(err), gin.H{"Couldn't get courier": err.Error()})
+		return
+	}
+	h.Audit.Record(c, action, "courier", req.UserID, before, after)
+	c.JSON(http.StatusOK, after)
+}
+
+func courierStatus(err error) int {
+	switch {
+	case errors.Is(err, managers.ErrReasonRequired):
+		return http.StatusBadRequest
+	case errors.Is(err, managers.ErrCourierNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, managers.ErrIllegalCourierStatus):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
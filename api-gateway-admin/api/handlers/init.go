@@ -1,13 +1,22 @@
 package handlers
 
 import (
-	"auth-service/service"
+	"gateway-admin/genprotos"
+	"gateway-admin/service"
+	"gateway-admin/storage"
+	"gateway-auth/audit"
+
+	"google.golang.org/grpc"
 )
 
 type HTTPHandler struct {
-	US *service.UserService
+	US           *service.UserService
+	OrderManager genprotos.OrderServiceClient
+	Documents    *storage.DocumentStore
+	LS           *service.LocationService
+	Audit        *audit.Recorder
 }
 
-func NewHandler(us *service.UserService) *HTTPHandler {
-	return &HTTPHandler{US: us}
+func NewHandler(us *service.UserService, connO *grpc.ClientConn, documents *storage.DocumentStore, ls *service.LocationService, audit *audit.Recorder) *HTTPHandler {
+	return &HTTPHandler{US: us, OrderManager: genprotos.NewOrderServiceClient(connO), Documents: documents, LS: ls, Audit: audit}
 }
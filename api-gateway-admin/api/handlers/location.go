@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"gateway-admin/genprotos"
+	"gateway-admin/models"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+// NearbyCouriers godoc
+// @Summary Find couriers near a point
+// @Description Finds couriers whose latest reported position is within radius_km of a point, closest first. Positions a courier stopped reporting are left out. Requires the courier:track permission.
+// @Tags courier
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius_km query number false "Radius in kilometers, at most 50" default(3)
+// @Param online query bool false "Only couriers dispatch can offer deliveries to" default(true)
+// @Param limit query int false "At most this many, at most 100" default(20)
+// @Success 200 {array} models.NearbyCourier "Couriers"
+// @Failure 400 {object} string "Invalid query"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /couriers/nearby [get]
+func (h *HTTPHandler) NearbyCouriers(c *gin.Context) {
+	req := &models.NearbyCouriersReq{}
+	var err error
+	if req.Lat, err = strconv.ParseFloat(c.Query("lat"), 64); err != nil || req.Lat < -90 || req.Lat > 90 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lat must be between -90 and 90"})
+		return
+	}
+	if req.Lng, err = strconv.ParseFloat(c.Query("lng"), 64); err != nil || req.Lng < -180 || req.Lng > 180 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lng must be between -180 and 180"})
+		return
+	}
+	if req.RadiusKm, err = strconv.ParseFloat(c.DefaultQuery("radius_km", "3"), 64); err != nil || req.RadiusKm <= 0 || req.RadiusKm > 50 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "radius_km must be more than 0 and at most 50"})
+		return
+	}
+	if req.Online, err = strconv.ParseBool(c.DefaultQuery("online", "true")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "online must be true or false"})
+		return
+	}
+	if req.Limit, err = strconv.Atoi(c.DefaultQuery("limit", "20")); err != nil || req.Limit < 1 || req.Limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+		return
+	}
+
+	couriers, err := h.LS.NearbyCouriers(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't find couriers": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, couriers)
+}
+
+// TrackOrder godoc
+// @Summary Track an order's delivery
+// @Description Gets the positions the courier reported while carrying an order, oldest first. Tracks are kept for a while after delivery. Requires the courier:track permission.
+// @Tags order
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} models.DeliveryTrack "Track"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /orders/{id}/track [get]
+func (h *HTTPHandler) TrackOrder(c *gin.Context) {
+	order, err := h.OrderManager.GetOrder(context.Background(), &genprotos.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get order": status.Convert(err).Message()})
+		return
+	}
+	points, err := h.LS.Track(order.Id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get track": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.DeliveryTrack{OrderID: order.Id, CourierID: order.CourierId, Status: order.Status, Points: points})
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"gateway-admin/genprotos"
+	"gateway-admin/storage/managers"
+	"gateway-auth/middleware"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOrder godoc
+// @Summary Get an order
+// @Description Gets any order by id. Requires the order:read permission.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} genprotos.OrderGRes "Order data"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-order/{id} [get]
+func (h *HTTPHandler) GetOrder(c *gin.Context) {
+	res, err := h.OrderManager.GetOrder(context.Background(), &genprotos.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get order": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateOrderStatus godoc
+// @Summary Update order status
+// @Description Moves an order to another status. The caller acts as staff in the order state machine.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param data body genprotos.OrderStatusUReq true "New status and reason"
+// @Success 200 {object} genprotos.OrderGRes "Updated order"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 409 {object} string "Illegal status transition"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /update-order-status/{id} [put]
+func (h *HTTPHandler) UpdateOrderStatus(c *gin.Context) {
+	var req genprotos.OrderStatusUReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	req.OrderId = c.Param("id")
+	req.ActorId, req.ActorRole = middleware.Actor(c)
+	req.ActorKind = middleware.ActorStaff
+
+	before, err := h.OrderManager.GetOrder(context.Background(), &genprotos.ByID{Id: req.OrderId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't update order status": status.Convert(err).Message()})
+		return
+	}
+	res, err := h.OrderManager.UpdateStatus(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't update order status": status.Convert(err).Message()})
+		return
+	}
+	h.Audit.Record(c, managers.AuditOrderStatusUpdated, "order", req.OrderId, before, res)
+	c.JSON(http.StatusOK, res)
+}
+
+// GetOrderHistory godoc
+// @Summary Get order status history
+// @Description Gets the status timeline of an order: who changed it, when and why.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} genprotos.OrderHistoryRes "Order status history"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-order-history/{id} [get]
+func (h *HTTPHandler) GetOrderHistory(c *gin.Context) {
+	res, err := h.OrderManager.GetHistory(context.Background(), &genprotos.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get order history": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,8 +1,9 @@
 package handlers
 
 import (
-	"auth-service/config"
-	"auth-service/models"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,7 +11,7 @@ import (
 
 // AddProductManager godoc
 // @Summary Add a product-manager
-// @Description Adds a product-manager to the system. Only admins are allowed to use this function.
+// @Description Adds a product-manager to the system. Requires the staff:manage permission.
 // @Tags product-manager
 // @Accept json
 // @Produce json
@@ -48,17 +49,18 @@ func (h *HTTPHandler) AddProductManager(c *gin.Context) {
 
 	req.Password = string(hashedPassword)
 
-	err = h.US.AddProductManager(&req)
+	user, err := h.US.AddProductManager(&req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't add product-manager": err.Error()})
 		return
 	}
+	h.Audit.Record(c, managers.AuditManagerAdded, "user", user.ID, nil, user)
 	c.JSON(http.StatusOK, gin.H{"ProductManager is added": req.Email})
 }
 
 // DeleteProductManager godoc
 // @Summary Delete a product-manager
-// @Description Deletes a product-manager from the system. Only admins are allowed to use this function.
+// @Description Deletes a product-manager from the system. Requires the staff:manage permission.
 // @Tags product-manager
 // @Accept json
 // @Produce json
@@ -77,18 +79,20 @@ func (h *HTTPHandler) DeleteProductManager(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email format"})
 			return
 		}
-		err := h.US.DeleteProductManager(&models.DeleteProductManagerReq{Email: id_or_email})
+		user, err := h.US.DeleteProductManager(&models.DeleteProductManagerReq{Email: id_or_email})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't delete product-manager": err.Error()})
 			return
 		}
+		h.Audit.Record(c, managers.AuditManagerDeleted, "user", user.ID, user, nil)
 		c.JSON(http.StatusOK, gin.H{"ProductManager is deleted!": id_or_email})
 	} else if data == "id" {
-		err := h.US.DeleteProductManager(&models.DeleteProductManagerReq{ID: id_or_email})
+		user, err := h.US.DeleteProductManager(&models.DeleteProductManagerReq{ID: id_or_email})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't delete product-manager": err.Error()})
 			return
 		}
+		h.Audit.Record(c, managers.AuditManagerDeleted, "user", user.ID, user, nil)
 		c.JSON(http.StatusOK, gin.H{"ProductManager is deleted": id_or_email})
 	}
 }
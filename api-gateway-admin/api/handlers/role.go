@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListPermissions godoc
+// @Summary List permissions
+// @Description Lists every permission a role can be granted.
+// @Tags roles
+// @Produce json
+// @Success 200 {array} models.Permission "Permissions"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /permissions [get]
+func (h *HTTPHandler) ListPermissions(c *gin.Context) {
+	permissions, err := h.US.ListPermissions()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list permissions": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, permissions)
+}
+
+// ListRoles godoc
+// @Summary List roles
+// @Description Lists built-in and custom roles with their permissions.
+// @Tags roles
+// @Produce json
+// @Success 200 {array} models.Role "Roles"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /roles [get]
+func (h *HTTPHandler) ListRoles(c *gin.Context) {
+	roles, err := h.US.ListRoles()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list roles": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roles)
+}
+
+// CreateRole godoc
+// @Summary Create a role
+// @Description Creates a custom role, such as a support agent, with the given permissions.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param role body models.CreateRoleReq true "Role"
+// @Success 201 {object} string "Role created"
+// @Failure 400 {object} string "Invalid name or unknown permission"
+// @Failure 409 {object} string "Role already exists"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /roles [post]
+func (h *HTTPHandler) CreateRole(c *gin.Context) {
+	var req models.CreateRoleReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if err := h.US.CreateRole(&req); err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't create role": err.Error()})
+		return
+	}
+	h.Audit.Record(c, managers.AuditRoleCreated, "role", req.Name, nil, req)
+	c.JSON(http.StatusCreated, gin.H{"Role created": req.Name})
+}
+
+// UpdateRole godoc
+// @Summary Update a role
+// @Description Changes a role's description, 2FA requirement or permissions. Given permissions replace the current ones. Users with the role get the change within the gateways' token cache TTL.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Param role body models.UpdateRoleReq true "Changes"
+// @Success 200 {object} string "Role updated"
+// @Failure 400 {object} string "Unknown permission"
+// @Failure 404 {object} string "Role not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /roles/{name} [put]
+func (h *HTTPHandler) UpdateRole(c *gin.Context) {
+	var req models.UpdateRoleReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	req.Name = c.Param("name")
+	before, err := h.US.GetRole(req.Name)
+	if err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't update role": err.Error()})
+		return
+	}
+	if err := h.US.UpdateRole(&req); err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't update role": err.Error()})
+		return
+	}
+	after, _ := h.US.GetRole(req.Name)
+	h.Audit.Record(c, managers.AuditRoleUpdated, "role", req.Name, before, after)
+	c.JSON(http.StatusOK, gin.H{"Role updated": req.Name})
+}
+
+// DeleteRole godoc
+// @Summary Delete a role
+// @Description Deletes a custom role that no user has.
+// @Tags roles
+// @Produce json
+// @Param name path string true "Role name"
+// @Success 200 {object} string "Role deleted"
+// @Failure 404 {object} string "Role not found"
+// @Failure 409 {object} string "Role is built in or still in use"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /roles/{name} [delete]
+func (h *HTTPHandler) DeleteRole(c *gin.Context) {
+	before, err := h.US.GetRole(c.Param("name"))
+	if err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't delete role": err.Error()})
+		return
+	}
+	if err := h.US.DeleteRole(c.Param("name")); err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't delete role": err.Error()})
+		return
+	}
+	h.Audit.Record(c, managers.AuditRoleDeleted, "role", before.Name, before, nil)
+	c.JSON(http.StatusOK, gin.H{"Role deleted": c.Param("name")})
+}
+
+// AssignRole godoc
+// @Summary Assign a role
+// @Description Gives a user another role. Banned users keep their role until the ban is lifted.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param role body models.AssignRoleReq true "Role"
+// @Success 200 {object} string "Role assigned"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "User or role not found"
+// @Failure 409 {object} string "User is banned"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /users/{id}/role [put]
+func (h *HTTPHandler) AssignRole(c *gin.Context) {
+	var req models.AssignRoleReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	req.UserID = c.Param("id")
+	if err := config.IsValidUUID(req.UserID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	previousRole, err := h.US.AssignRole(&req)
+	if err != nil {
+		c.JSON(roleStatus(err), gin.H{"Couldn't assign role": err.Error()})
+		return
+	}
+	h.Audit.Record(c, managers.AuditUserRoleAssigned, "user", req.UserID, gin.H{"role": previousRole}, gin.H{"role": req.Role})
+	c.JSON(http.StatusOK, gin.H{"Role assigned": req.Role})
+}
+
+func roleStatus(err error) int {
+	switch {
+	case errors.Is(err, managers.ErrInvalidRoleName), errors.Is(err, managers.ErrUnknownPermission), errors.Is(err, managers.ErrAssignBanned):
+		return http.StatusBadRequest
+	case errors.Is(err, managers.ErrRoleNotFound), errors.Is(err, managers.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, managers.ErrRoleExists), errors.Is(err, managers.ErrRoleBuiltin), errors.Is(err, managers.ErrRoleInUse), errors.Is(err, managers.ErrAlreadyBanned):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
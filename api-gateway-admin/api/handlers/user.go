@@ -1,83 +1,174 @@
 package handlers
 
 import (
-	"auth-service/config"
-	"auth-service/models"
+	"errors"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
 )
 
 // BanUser godoc
 // @Summary Ban a user
-// @Description Bans a user which doesn't allow user to use. Only admins are allowed to use this function.
+// @Description Bans a user, courier or manager until expires_at, for duration, or permanently if neither is given. Their role is restored when the ban expires or is lifted. Requires the user:ban permission.
 // @Tags banning
 // @Accept json
 // @Produce json
 // @Param id path string true "id or email of the user"
 // @Param data query string true "Search with" Enums(id, email)
-// @Success 200 {object} string "User is banned"
+// @Param ban body models.BanUserBody true "Reason, notes and expiry"
+// @Success 200 {object} models.UserBan "User is banned"
 // @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "User not found"
+// @Failure 409 {object} string "User is already banned or can manage roles"
 // @Failure 500 {object} string "Server error"
 // @Security BearerAuth
 // @Router /ban/{id} [put]
 func (h *HTTPHandler) BanUser(c *gin.Context) {
-	id_or_email := c.Param("id")
-	data := c.Query("data")
-	if data == "email" {
-		err := h.US.BanUser(&models.BanUserReq{Email: id_or_email})
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't ban user": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, gin.H{"User is banned!": id_or_email})
-	} else if data == "id" {
-		if err := config.IsValidUUID(id_or_email); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	id, email, ok := userRef(c)
+	if !ok {
+		return
+	}
+	var body models.BanUserBody
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if strings.TrimSpace(body.Reason) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reason is required"})
+		return
+	}
+	if body.Duration != "" {
+		if body.ExpiresAt != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "give either expires_at or duration"})
 			return
 		}
-		err := h.US.BanUser(&models.BanUserReq{ID: id_or_email})
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't ban user": err.Error()})
+		duration, err := time.ParseDuration(body.Duration)
+		if err != nil || duration <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "duration must be positive, like 72h"})
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"User is banned": id_or_email})
+		expiresAt := time.Now().Add(duration)
+		body.ExpiresAt = &expiresAt
+	}
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be in the future"})
+		return
+	}
+
+	adminID, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	ban, err := h.US.BanUser(&models.BanUserReq{
+		ID:        id,
+		Email:     email,
+		Reason:    body.Reason,
+		Notes:     body.Notes,
+		ExpiresAt: body.ExpiresAt,
+		IssuedBy:  adminID,
+	})
+	if err != nil {
+		c.JSON(banStatus(err), gin.H{"Couldn't ban user": err.Error()})
+		return
 	}
+	h.Audit.Record(c, managers.AuditUserBanned, "user", ban.UserID, models.UserSnapshot{ID: ban.UserID, Role: ban.PreviousRole}, ban)
+	c.JSON(http.StatusOK, ban)
 }
 
 // UnbanUser godoc
 // @Summary Unban a user
-// @Description Unbans a user which allows user to use. Only admins are allowed to use this function.
+// @Description Lifts a user's ban before it expires and gives them back the role they had. Requires the user:ban permission.
 // @Tags banning
 // @Accept json
 // @Produce json
 // @Param id path string true "id or email of the user"
 // @Param data query string true "Search with" Enums(id, email)
+// @Param reason body models.UnbanUserBody false "Why the ban is lifted"
 // @Success 200 {object} string "User is unbanned"
 // @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "User not found"
+// @Failure 409 {object} string "User is not banned"
 // @Failure 500 {object} string "Server error"
 // @Security BearerAuth
 // @Router /unban/{id} [put]
 func (h *HTTPHandler) UnbanUser(c *gin.Context) {
-	id_or_email := c.Param("id")
-	data := c.Query("data")
-	if data == "email" {
-		err := h.US.UnbanUser(&models.UnbanUserReq{Email: id_or_email})
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't unban user": err.Error()})
+	id, email, ok := userRef(c)
+	if !ok {
+		return
+	}
+	var body models.UnbanUserBody
+	if c.Request.ContentLength > 0 {
+		if err := c.BindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"User is unbanned!": id_or_email})
-	} else if data == "id" {
-		if err := config.IsValidUUID(id_or_email); err != nil {
+	}
+
+	adminID, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	user, err := h.US.UnbanUser(&models.UnbanUserReq{ID: id, Email: email, Reason: body.Reason, LiftedBy: adminID})
+	if err != nil {
+		c.JSON(banStatus(err), gin.H{"Couldn't unban user": err.Error()})
+		return
+	}
+	h.Audit.Record(c, managers.AuditUserUnbanned, "user", user.ID, models.UserSnapshot{ID: user.ID, Email: user.Email, Role: "banned"}, user)
+	c.JSON(http.StatusOK, gin.H{"User is unbanned": c.Param("id")})
+}
+
+// ListBans godoc
+// @Summary List a user's bans
+// @Description Lists every ban a user has had, newest first, including lifted and expired ones. Requires the user:ban permission.
+// @Tags banning
+// @Produce json
+// @Param id path string true "id or email of the user"
+// @Param data query string true "Search with" Enums(id, email)
+// @Success 200 {array} models.UserBan "Bans"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "User not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /bans/{id} [get]
+func (h *HTTPHandler) ListBans(c *gin.Context) {
+	id, email, ok := userRef(c)
+	if !ok {
+		return
+	}
+	bans, err := h.US.ListBans(&models.BanUserReq{ID: id, Email: email})
+	if err != nil {
+		c.JSON(banStatus(err), gin.H{"Couldn't list bans": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, bans)
+}
+
+// userRef reads the user from the id path parameter, which holds an ID or an
+// email depending on the data query parameter.
+func userRef(c *gin.Context) (id, email string, ok bool) {
+	idOrEmail := c.Param("id")
+	switch c.Query("data") {
+	case "email":
+		return "", idOrEmail, true
+	case "id":
+		if err := config.IsValidUUID(idOrEmail); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			return
-		}
-		err := h.US.UnbanUser(&models.UnbanUserReq{ID: id_or_email})
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"Couldn't unban user": err.Error()})
-			return
+			return "", "", false
 		}
-		c.JSON(http.StatusOK, gin.H{"User is unbanned": id_or_email})
+		return idOrEmail, "", true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data must be id or email"})
+		return "", "", false
+	}
+}
+
+func banStatus(err error) int {
+	switch {
+	case errors.Is(err, managers.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, managers.ErrAlreadyBanned), errors.Is(err, managers.ErrNotBanned), errors.Is(err, managers.ErrBanProtected):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
 	}
 }
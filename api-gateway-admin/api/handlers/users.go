@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"gateway-admin/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListUsers godoc
+// @Summary List users
+// @Description Lists users, newest first, with optional filters. With format=csv every matching user is returned as a CSV file and limit/offset are ignored. Requires the user:read permission.
+// @Tags users
+// @Produce json,text/csv
+// @Param role query string false "Role"
+// @Param confirmed query bool false "Whether the email is confirmed"
+// @Param created_from query string false "Created at or after, RFC 3339 or YYYY-MM-DD"
+// @Param created_to query string false "Created before, RFC 3339 or YYYY-MM-DD"
+// @Param email query string false "Part of the email"
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param offset query int false "Offset"
+// @Param format query string false "Response format" Enums(json, csv)
+// @Success 200 {object} models.ListUsersResp "Users"
+// @Failure 400 {object} string "Invalid filter"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /users [get]
+func (h *HTTPHandler) ListUsers(c *gin.Context) {
+	req, err := listUsersReq(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	csvFormat := c.Query("format") == "csv"
+	if csvFormat {
+		req.Limit, req.Offset = 0, 0
+	}
+
+	res, err := h.US.ListUsers(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list users": err.Error()})
+		return
+	}
+	if !csvFormat {
+		c.JSON(http.StatusOK, res)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="users.csv"`)
+	c.Header("Content-Type", "text/csv")
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"id", "email", "role", "is_confirmed", "created_at", "confirmed_at"})
+	for _, user := range res.Users {
+		confirmedAt := ""
+		if user.ConfirmedAt != nil {
+			confirmedAt = user.ConfirmedAt.Format(time.RFC3339)
+		}
+		w.Write([]string{user.ID, user.Email, user.Role, strconv.FormatBool(user.IsConfirmed), user.CreatedAt.Format(time.RFC3339), confirmedAt})
+	}
+	w.Flush()
+}
+
+func listUsersReq(c *gin.Context) (*models.ListUsersReq, error) {
+	req := &models.ListUsersReq{Role: c.Query("role"), Email: c.Query("email")}
+	if v := c.Query("confirmed"); v != "" {
+		confirmed, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.New("confirmed must be true or false")
+		}
+		req.IsConfirmed = &confirmed
+	}
+	var err error
+	if req.CreatedFrom, err = queryTime(c, "created_from"); err != nil {
+		return nil, err
+	}
+	if req.CreatedTo, err = queryTime(c, "created_to"); err != nil {
+		return nil, err
+	}
+
+	if req.Limit, err = strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err != nil || req.Limit < 1 || req.Limit > 100 {
+		return nil, errors.New("limit must be between 1 and 100")
+	}
+	if req.Offset, err = strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err != nil || req.Offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	return req, nil
+}
+
+func queryTime(c *gin.Context, key string) (*time.Time, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, errors.New(key + " must be RFC 3339 or YYYY-MM-DD")
+}
@@ -1,52 +0,0 @@
-package middleware
-
-import (
-	"auth-service/api/token"
-	"net/http"
-
-	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt"
-)
-
-func JWTMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
-			c.Abort()
-			return
-		}
-		valid, err := token.ValidateToken(authHeader)
-		if err != nil || !valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token", "details": err.Error()})
-			c.Abort()
-			return
-		}
-
-		claims, err := token.ExtractClaim(authHeader)
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims", "details": err.Error()})
-			c.Abort()
-			return
-		}
-		c.Set("claims", claims)
-		c.Next()
-	}
-}
-
-func IsAdminMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		claims, exists := c.Get("claims")
-		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-			c.Abort()
-			return
-		}
-		role := claims.(jwt.MapClaims)["role"].(string)
-		if role != "admin" {
-			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
-			c.Abort()
-		}
-		c.Next()
-	}
-}
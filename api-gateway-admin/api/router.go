@@ -7,9 +7,9 @@ import (
 
 	ginSwagger "github.com/swaggo/gin-swagger"
 
-	_ "auth-service/api/docs"
-	"auth-service/api/handlers"
-	"auth-service/api/middleware"
+	_ "gateway-admin/api/docs"
+	"gateway-admin/api/handlers"
+	"gateway-auth/middleware"
 )
 
 // @title Swaggers of admin panel
@@ -18,21 +18,51 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-func NewRouter(h *handlers.HTTPHandler) *gin.Engine {
+func NewRouter(h *handlers.HTTPHandler, auth *middleware.AuthClient) *gin.Engine {
 	router := gin.Default()
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	protected := router.Group("/", middleware.JWTMiddleware())
-	protected.Use(middleware.IsAdminMiddleware())
+	protected := router.Group("/", middleware.JWTMiddleware(auth))
 
-	protected.PUT("/ban/:id", h.BanUser)
-	protected.PUT("/unban/:id", h.UnbanUser)
+	users := protected.Group("/", middleware.RequirePermission("user:read"))
+	users.GET("/users", h.ListUsers)
 
-	protected.POST("/add-courier", h.AddCourier)
-	protected.DELETE("/delete-courier/:id", h.DeleteCourier)
+	bans := protected.Group("/", middleware.RequirePermission("user:ban"))
+	bans.PUT("/ban/:id", h.BanUser)
+	bans.PUT("/unban/:id", h.UnbanUser)
+	bans.GET("/bans/:id", h.ListBans)
 
-	protected.POST("/add-product-manager", h.AddProductManager)
-	protected.DELETE("/delete-product-manager/:id", h.DeleteProductManager)
+	staff := protected.Group("/", middleware.RequirePermission("staff:manage"))
+	staff.POST("/add-courier", h.AddCourier)
+	staff.DELETE("/delete-courier/:id", h.DeleteCourier)
+	staff.POST("/add-product-manager", h.AddProductManager)
+	staff.DELETE("/delete-product-manager/:id", h.DeleteProductManager)
+
+	couriers := protected.Group("/", middleware.RequirePermission("courier:review"))
+	couriers.GET("/couriers", h.ListCouriers)
+	couriers.GET("/couriers/:id", h.GetCourier)
+	couriers.PUT("/couriers/:id/approve", h.ApproveCourier)
+	couriers.PUT("/couriers/:id/reject", h.RejectCourier)
+	couriers.PUT("/couriers/:id/suspend", h.SuspendCourier)
+	couriers.PUT("/couriers/:id/reinstate", h.ReinstateCourier)
+
+	tracking := protected.Group("/", middleware.RequirePermission("courier:track"))
+	tracking.GET("/couriers/nearby", h.NearbyCouriers)
+	tracking.GET("/orders/:id/track", h.TrackOrder)
+
+	protected.GET("/audit-logs", middleware.RequirePermission("audit:read"), h.ListAuditLogs)
+
+	roles := protected.Group("/", middleware.RequirePermission("role:manage"))
+	roles.GET("/permissions", h.ListPermissions)
+	roles.GET("/roles", h.ListRoles)
+	roles.POST("/roles", h.CreateRole)
+	roles.PUT("/roles/:name", h.UpdateRole)
+	roles.DELETE("/roles/:name", h.DeleteRole)
+	roles.PUT("/users/:id/role", h.AssignRole)
+
+	protected.GET("/get-order/:id", middleware.RequirePermission("order:read"), h.GetOrder)
+	protected.PUT("/update-order-status/:id", middleware.RequirePermission("order:update"), h.UpdateOrderStatus)
+	protected.GET("/get-order-history/:id", middleware.RequirePermission("order:read"), h.GetOrderHistory)
 
 	return router
 }
@@ -1,11 +1,17 @@
 package main
 
 import (
-	"auth-service/api"
-	"auth-service/api/handlers"
-	"auth-service/config"
-	"auth-service/service"
-	"auth-service/storage"
+	"fmt"
+	"gateway-admin/api"
+	"gateway-admin/api/handlers"
+	"gateway-admin/config"
+	"gateway-admin/service"
+	"gateway-admin/storage"
+	"gateway-auth/audit"
+	"gateway-auth/middleware"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -16,10 +22,26 @@ func main() {
 	em.CheckErr(err)
 	defer pgsql.Close()
 
+	AuthConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.AUTH_GRPC_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer AuthConn.Close()
+
+	OrderConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.ORDER_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer OrderConn.Close()
+
+	documents, err := storage.ConnectDocuments(&cf)
+	em.CheckErr(err)
+
+	rdb, err := storage.ConnectRedis(&cf)
+	em.CheckErr(err)
+	defer rdb.Close()
+
 	us := service.NewUserService(pgsql, mongo)
-	handler := handlers.NewHandler(us)
+	ls := service.NewLocationService(rdb, &us.UM)
+	handler := handlers.NewHandler(us, OrderConn, documents, ls, audit.NewRecorder(pgsql, "admin"))
 
-	roter := api.NewRouter(handler)
+	roter := api.NewRouter(handler, middleware.NewAuthClient(AuthConn, cf.AUTH_CACHE_TTL))
 	if err := roter.Run(cf.API_GATEWAY_ADMIN_PORT); err != nil {
 		panic(err)
 	}
@@ -1,13 +1,60 @@
 package models
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type BanUserReq struct {
-	ID    string `json:"id"`    // Username of the profile to retrieve
-	Email string `json:"email"` // Username of the profile to retrieve
+	ID        string     `json:"id"`    // Username of the profile to retrieve
+	Email     string     `json:"email"` // Username of the profile to retrieve
+	Reason    string     `json:"reason"`
+	Notes     string     `json:"notes"`
+	ExpiresAt *time.Time `json:"expires_at"` // Permanent ban if nil
+	IssuedBy  string     `json:"issued_by"`
+}
+
+// BanUserBody is what an admin sends to ban a user. A ban without expires_at
+// or duration is permanent.
+type BanUserBody struct {
+	Reason    string     `json:"reason"`
+	Notes     string     `json:"notes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Duration  string     `json:"duration"` // Go duration such as "72h", instead of expires_at
 }
 
 type UnbanUserReq struct {
-	ID    string `json:"id"`    // Username of the profile to retrieve
-	Email string `json:"email"` // Username of the profile to retrieve
+	ID       string `json:"id"`    // Username of the profile to retrieve
+	Email    string `json:"email"` // Username of the profile to retrieve
+	Reason   string `json:"reason"`
+	LiftedBy string `json:"lifted_by"`
+}
+
+type UnbanUserBody struct {
+	Reason string `json:"reason"` // Why the ban is lifted early, e.g. an accepted appeal
+}
+
+type UserBan struct {
+	ID           string     `json:"id"`
+	UserID       string     `json:"user_id"`
+	PreviousRole string     `json:"previous_role"`
+	Reason       string     `json:"reason"`
+	Notes        string     `json:"notes,omitempty"`
+	IssuedBy     string     `json:"issued_by,omitempty"`
+	StartsAt     time.Time  `json:"starts_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	LiftedAt     *time.Time `json:"lifted_at,omitempty"`
+	LiftedBy     string     `json:"lifted_by,omitempty"`
+	LiftReason   string     `json:"lift_reason,omitempty"`
+	Active       bool       `json:"active"`
+}
+
+// UserSnapshot is what the audit log records about a user before or after a
+// change.
+type UserSnapshot struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
 }
 
 type AddCourierReq struct {
@@ -29,3 +76,189 @@ type DeleteProductManagerReq struct {
 	ID    string `json:"id"`
 	Email string `json:"email"`
 }
+
+// ListUsersReq filters the admin user listing. Empty fields don't filter.
+type ListUsersReq struct {
+	Role        string
+	IsConfirmed *bool
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Email       string // Substring of the email, case insensitive
+	Limit       int64  // No limit if 0
+	Offset      int64
+}
+
+type UserInfo struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	Role        string     `json:"role"`
+	IsConfirmed bool       `json:"is_confirmed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+}
+
+type ListUsersResp struct {
+	Users  []UserInfo `json:"users"`
+	Total  int64      `json:"total"`
+	Limit  int64      `json:"limit"`
+	Offset int64      `json:"offset"`
+}
+
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Role struct {
+	Name              string    `json:"name"`
+	Description       string    `json:"description"`
+	Builtin           bool      `json:"builtin"`
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	Permissions       []string  `json:"permissions"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type CreateRoleReq struct {
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	TwoFactorRequired bool     `json:"two_factor_required"`
+	Permissions       []string `json:"permissions"`
+}
+
+// UpdateRoleReq changes a role. Fields left out stay as they are; permissions,
+// when given, replace the role's permissions.
+type UpdateRoleReq struct {
+	Name              string    `json:"-"`
+	Description       *string   `json:"description"`
+	TwoFactorRequired *bool     `json:"two_factor_required"`
+	Permissions       *[]string `json:"permissions"`
+}
+
+type AssignRoleReq struct {
+	UserID string `json:"-"`
+	Role   string `json:"role"`
+}
+
+// AuditLog is one privileged change. Before and after are snapshots of the
+// target, either of which is null when there was nothing there.
+type AuditLog struct {
+	ID         int64           `json:"id"`
+	ActorID    string          `json:"actor_id"`
+	ActorRole  string          `json:"actor_role"`
+	Source     string          `json:"source"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Before     json.RawMessage `json:"before" swaggertype:"object"`
+	After      json.RawMessage `json:"after" swaggertype:"object"`
+	IP         string          `json:"ip"`
+	UserAgent  string          `json:"user_agent"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// ListAuditLogsReq filters the audit log. Empty fields don't filter.
+type ListAuditLogsReq struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Source     string
+	From       *time.Time
+	To         *time.Time
+	Limit      int64 // No limit if 0
+	Offset     int64
+}
+
+type ListAuditLogsResp struct {
+	Logs   []AuditLog `json:"logs"`
+	Total  int64      `json:"total"`
+	Limit  int64      `json:"limit"`
+	Offset int64      `json:"offset"`
+}
+
+type CourierProfile struct {
+	UserID       string            `json:"user_id"`
+	Email        string            `json:"email"`
+	FullName     string            `json:"full_name"`
+	Phone        string            `json:"phone"`
+	VehicleType  string            `json:"vehicle_type"`
+	CapacityKg   float64           `json:"capacity_kg"`
+	Status       string            `json:"status"`
+	StatusReason string            `json:"status_reason,omitempty"`
+	ReviewedBy   string            `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time        `json:"reviewed_at,omitempty"`
+	SubmittedAt  *time.Time        `json:"submitted_at,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	Online       bool              `json:"online"` // On shift and available to dispatch
+	Documents    []CourierDocument `json:"documents,omitempty"`
+}
+
+type CourierDocument struct {
+	ID          string    `json:"id"`
+	Kind        string    `json:"kind"`
+	ObjectKey   string    `json:"-"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+	URL         string    `json:"url,omitempty"` // Presigned, expires after a while
+}
+
+type ListCouriersReq struct {
+	Status string
+	Online bool // Only couriers on shift and available to dispatch
+	Limit  int64
+	Offset int64
+}
+
+type ListCouriersResp struct {
+	Couriers []CourierProfile `json:"couriers"`
+	Total    int64            `json:"total"`
+	Limit    int64            `json:"limit"`
+	Offset   int64            `json:"offset"`
+}
+
+// ReviewCourierReq moves a courier to another onboarding status.
+type ReviewCourierReq struct {
+	UserID     string `json:"-"`
+	Reason     string `json:"reason"` // Required to reject or suspend
+	ReviewedBy string `json:"-"`
+}
+
+type NearbyCouriersReq struct {
+	Lat      float64
+	Lng      float64
+	RadiusKm float64
+	Online   bool // Only couriers on shift and available to dispatch
+	Limit    int
+}
+
+// NearbyCourier is a courier's latest reported position.
+type NearbyCourier struct {
+	UserID     string    `json:"user_id"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	DistanceKm float64   `json:"distance_km"`
+	AccuracyM  float64   `json:"accuracy_m,omitempty"`
+	SpeedKmh   float64   `json:"speed_kmh,omitempty"`
+	Heading    float64   `json:"heading,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Online     bool      `json:"online"`
+}
+
+// TrackPoint is a breadcrumb of a delivery, as the courier's device reported it.
+type TrackPoint struct {
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	AccuracyM  float64   `json:"accuracy_m,omitempty"`
+	SpeedKmh   float64   `json:"speed_kmh,omitempty"`
+	Heading    float64   `json:"heading,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type DeliveryTrack struct {
+	OrderID   string       `json:"order_id"`
+	CourierID string       `json:"courier_id"`
+	Status    string       `json:"status"`
+	Points    []TrackPoint `json:"points"` // Oldest first, only the latest ones are kept
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type LocationService struct {
+	LM managers.LocationManager
+	UM *managers.UserManager
+}
+
+func NewLocationService(rdb *redis.Client, um *managers.UserManager) *LocationService {
+	return &LocationService{LM: *managers.NewLocationManager(rdb), UM: um}
+}
+
+// NearbyCouriers finds couriers around a point, closest first, and marks the
+// ones dispatch can offer deliveries to.
+func (s *LocationService) NearbyCouriers(req *models.NearbyCouriersReq) ([]models.NearbyCourier, error) {
+	found, err := s.LM.Nearby(req.Lat, req.Lng, req.RadiusKm)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(found))
+	for i, courier := range found {
+		ids[i] = courier.UserID
+	}
+	online, err := s.UM.OnlineCouriers(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	couriers := []models.NearbyCourier{}
+	for _, courier := range found {
+		courier.Online = online[courier.UserID]
+		if req.Online && !courier.Online {
+			continue
+		}
+		couriers = append(couriers, courier)
+		if len(couriers) == req.Limit {
+			break
+		}
+	}
+	return couriers, nil
+}
+
+func (s *LocationService) Track(orderID string) ([]models.TrackPoint, error) {
+	return s.LM.Track(orderID)
+}
@@ -1,10 +1,10 @@
 package service
 
 import (
-	"auth-service/config"
-	"auth-service/models"
-	"auth-service/storage/managers"
 	"database/sql"
+	"gateway-admin/config"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -21,26 +21,78 @@ func (u *UserService) IsEmailExists(email string) error {
 	return u.UM.IsEmailExists(email)
 }
 
-func (u *UserService) BanUser(req *models.BanUserReq) error {
+func (u *UserService) BanUser(req *models.BanUserReq) (*models.UserBan, error) {
 	return u.UM.BanUser(*req)
 }
 
-func (u *UserService) UnbanUser(req *models.UnbanUserReq) error {
+func (u *UserService) UnbanUser(req *models.UnbanUserReq) (*models.UserSnapshot, error) {
 	return u.UM.UnbanUser(*req)
 }
 
-func (u *UserService) AddCourier(req *models.AddCourierReq) error {
+func (u *UserService) ListBans(req *models.BanUserReq) ([]models.UserBan, error) {
+	return u.UM.ListBans(*req)
+}
+
+func (u *UserService) AddCourier(req *models.AddCourierReq) (*models.UserSnapshot, error) {
 	return u.UM.AddCourier(req)
 }
 
-func (u *UserService) DeleteCourier(req *models.DeleteCourierReq) error {
+func (u *UserService) DeleteCourier(req *models.DeleteCourierReq) (*models.UserSnapshot, error) {
 	return u.UM.DeleteCourier(*req)
 }
 
-func (u *UserService) AddProductManager(req *models.AddProductManagerReq) error {
+func (u *UserService) AddProductManager(req *models.AddProductManagerReq) (*models.UserSnapshot, error) {
 	return u.UM.AddProductManager(req)
 }
 
-func (u *UserService) DeleteProductManager(req *models.DeleteProductManagerReq) error {
+func (u *UserService) DeleteProductManager(req *models.DeleteProductManagerReq) (*models.UserSnapshot, error) {
 	return u.UM.DeleteProductManager(*req)
 }
+
+func (u *UserService) ListUsers(req *models.ListUsersReq) (*models.ListUsersResp, error) {
+	return u.UM.ListUsers(req)
+}
+
+func (u *UserService) ListPermissions() ([]models.Permission, error) {
+	return u.UM.ListPermissions()
+}
+
+func (u *UserService) ListRoles() ([]models.Role, error) {
+	return u.UM.ListRoles()
+}
+
+func (u *UserService) GetRole(name string) (*models.Role, error) {
+	return u.UM.GetRole(name)
+}
+
+func (u *UserService) CreateRole(req *models.CreateRoleReq) error {
+	return u.UM.CreateRole(req)
+}
+
+func (u *UserService) UpdateRole(req *models.UpdateRoleReq) error {
+	return u.UM.UpdateRole(req)
+}
+
+func (u *UserService) DeleteRole(name string) error {
+	return u.UM.DeleteRole(name)
+}
+
+func (u *UserService) AssignRole(req *models.AssignRoleReq) (string, error) {
+	return u.UM.AssignRole(req)
+}
+
+func (u *UserService) ListAuditLogs(req *models.ListAuditLogsReq) (*models.ListAuditLogsResp, error) {
+	return u.UM.ListAuditLogs(req)
+}
+
+func (u *UserService) ListCouriers(req *models.ListCouriersReq) (*models.ListCouriersResp, error) {
+	return u.UM.ListCouriers(req)
+}
+
+func (u *UserService) GetCourier(userID string) (*models.CourierProfile, error) {
+	return u.UM.GetCourier(userID)
+}
+
+func (u *UserService) ReviewCourier(review managers.CourierReview, req *models.ReviewCourierReq) error {
+	return u.UM.ReviewCourier(review, req)
+}
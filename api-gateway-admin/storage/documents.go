@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"gateway-admin/config"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// DocumentStore reads the documents couriers upload through the courier
+// gateway. The bucket is private, so documents are shared as short-lived
+// presigned links.
+type DocumentStore struct {
+	Client *minio.Client
+	Bucket string
+	URLTTL time.Duration
+}
+
+func ConnectDocuments(cf *config.Config) (*DocumentStore, error) {
+	client, err := minio.New(cf.MINIO_ENDPOINT, &minio.Options{
+		Creds:  credentials.NewStaticV4(cf.MINIO_ACCESS_KEY, cf.MINIO_SECRET_KEY, ""),
+		Secure: cf.MINIO_USE_SSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DocumentStore{Client: client, Bucket: cf.COURIER_DOCS_BUCKET, URLTTL: cf.COURIER_DOCS_URL_TTL}, nil
+}
+
+// URL returns a link to the object that works for URLTTL.
+func (s *DocumentStore) URL(key string) (string, error) {
+	u, err := s.Client.PresignedGetObject(context.Background(), s.Bucket, key, s.URLTTL, url.Values{})
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
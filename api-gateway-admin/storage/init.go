@@ -1,7 +1,7 @@
 	package storage
 
 	import (
-		"auth-service/config"
+		"gateway-admin/config"
 		"context"
 		"database/sql"
 		"fmt"
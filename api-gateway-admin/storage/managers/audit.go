@@ -0,0 +1,92 @@
+package managers
+
+import (
+	"fmt"
+	"gateway-admin/models"
+	"strings"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditUserBanned         = "user.ban"
+	AuditUserUnbanned       = "user.unban"
+	AuditUserRoleAssigned   = "user.assign_role"
+	AuditCourierAdded       = "courier.add"
+	AuditCourierDeleted     = "courier.delete"
+	AuditManagerAdded       = "manager.add"
+	AuditManagerDeleted     = "manager.delete"
+	AuditRoleCreated        = "role.create"
+	AuditRoleUpdated        = "role.update"
+	AuditRoleDeleted        = "role.delete"
+	AuditOrderStatusUpdated = "order.update_status"
+	AuditCourierApproved    = "courier.approve"
+	AuditCourierRejected    = "courier.reject"
+	AuditCourierSuspended   = "courier.suspend"
+	AuditCourierReinstated  = "courier.reinstate"
+)
+
+// ListAuditLogs returns the entries matching req, newest first, along with how
+// many match in total.
+func (m *UserManager) ListAuditLogs(req *models.ListAuditLogsReq) (*models.ListAuditLogsResp, error) {
+	where := []string{"TRUE"}
+	args := []interface{}{}
+	filter := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if req.ActorID != "" {
+		filter("actor_id = $%d", req.ActorID)
+	}
+	if req.Action != "" {
+		filter("action = $%d", req.Action)
+	}
+	if req.TargetType != "" {
+		filter("target_type = $%d", req.TargetType)
+	}
+	if req.TargetID != "" {
+		filter("target_id = $%d", req.TargetID)
+	}
+	if req.Source != "" {
+		filter("source = $%d", req.Source)
+	}
+	if req.From != nil {
+		filter("created_at >= $%d", *req.From)
+	}
+	if req.To != nil {
+		filter("created_at < $%d", *req.To)
+	}
+	conds := " WHERE " + strings.Join(where, " AND ")
+
+	res := &models.ListAuditLogsResp{Logs: []models.AuditLog{}, Limit: req.Limit, Offset: req.Offset}
+	if err := m.PgClient.QueryRow("SELECT COUNT(*) FROM admin_audit_log"+conds, args...).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, COALESCE(actor_id::text, ''), COALESCE(actor_role, ''), source, action, target_type,
+		COALESCE(target_id, ''), COALESCE(before, 'null'), COALESCE(after, 'null'), COALESCE(ip, ''), COALESCE(user_agent, ''), created_at
+		FROM admin_audit_log` + conds + " ORDER BY created_at DESC, id DESC"
+	if req.Limit > 0 {
+		args = append(args, req.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	args = append(args, req.Offset)
+	query += fmt.Sprintf(" OFFSET $%d", len(args))
+
+	rows, err := m.PgClient.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entry models.AuditLog
+		var before, after []byte
+		err := rows.Scan(&entry.ID, &entry.ActorID, &entry.ActorRole, &entry.Source, &entry.Action, &entry.TargetType,
+			&entry.TargetID, &before, &after, &entry.IP, &entry.UserAgent, &entry.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entry.Before, entry.After = before, after
+		res.Logs = append(res.Logs, entry)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,149 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"gateway-admin/models"
+	"time"
+)
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrAlreadyBanned = errors.New("user is already banned")
+	ErrNotBanned     = errors.New("user is not banned")
+	ErrBanProtected  = errors.New("users who can manage roles can't be banned")
+)
+
+// lockUser finds the user by ID or email and locks their row until tx ends.
+func lockUser(tx *sql.Tx, id, email string) (userID, role string, err error) {
+	query := "SELECT id, role FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE"
+	arg := id
+	if id == "" {
+		query = "SELECT id, role FROM users WHERE email = $1 AND deleted_at IS NULL FOR UPDATE"
+		arg = email
+	}
+	err = tx.QueryRow(query, arg).Scan(&userID, &role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", ErrUserNotFound
+	}
+	return userID, role, err
+}
+
+// BanUser bans a user, courier or manager. Their role becomes "banned" and is
+// given back when the ban is lifted or expires.
+func (m *UserManager) BanUser(req models.BanUserReq) (*models.UserBan, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userID, role, err := lockUser(tx, req.ID, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if role == "banned" {
+		return nil, ErrAlreadyBanned
+	}
+	// Whoever can manage roles could give themselves back a role, so banning
+	// them is refused whatever their role is called
+	var protected bool
+	err = tx.QueryRow("SELECT EXISTS (SELECT 1 FROM role_permissions WHERE role = $1 AND permission = 'role:manage')", role).Scan(&protected)
+	if err != nil {
+		return nil, err
+	}
+	if protected {
+		return nil, ErrBanProtected
+	}
+
+	ban := &models.UserBan{
+		UserID:       userID,
+		PreviousRole: role,
+		Reason:       req.Reason,
+		Notes:        req.Notes,
+		IssuedBy:     req.IssuedBy,
+		StartsAt:     time.Now(),
+		ExpiresAt:    req.ExpiresAt,
+		Active:       true,
+	}
+	err = tx.QueryRow(`INSERT INTO user_bans (user_id, previous_role, reason, notes, issued_by, starts_at, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, '')::uuid, $6, $7) RETURNING id`,
+		userID, role, req.Reason, req.Notes, req.IssuedBy, ban.StartsAt, req.ExpiresAt).Scan(&ban.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec("UPDATE users SET role = 'banned' WHERE id = $1", userID); err != nil {
+		return nil, err
+	}
+	return ban, tx.Commit()
+}
+
+// UnbanUser lifts the user's ban before it expires, gives them back their
+// previous role and returns the user as they are now. Users banned before
+// bans were recorded become plain users.
+func (m *UserManager) UnbanUser(req models.UnbanUserReq) (*models.UserSnapshot, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userID, role, err := lockUser(tx, req.ID, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if role != "banned" {
+		return nil, ErrNotBanned
+	}
+
+	previousRole := "user"
+	err = tx.QueryRow(`UPDATE user_bans SET lifted_at = $2, lifted_by = NULLIF($3, '')::uuid, lift_reason = NULLIF($4, '')
+		WHERE user_id = $1 AND lifted_at IS NULL RETURNING previous_role`,
+		userID, time.Now(), req.LiftedBy, req.Reason).Scan(&previousRole)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	user := &models.UserSnapshot{ID: userID, Role: previousRole}
+	err = tx.QueryRow("UPDATE users SET role = $1 WHERE id = $2 RETURNING email", previousRole, userID).Scan(&user.Email)
+	if err != nil {
+		return nil, err
+	}
+	return user, tx.Commit()
+}
+
+// ListBans returns every ban the user has had, newest first.
+func (m *UserManager) ListBans(req models.BanUserReq) ([]models.UserBan, error) {
+	query := "SELECT id FROM users WHERE id = $1"
+	arg := req.ID
+	if req.ID == "" {
+		query = "SELECT id FROM users WHERE email = $1"
+		arg = req.Email
+	}
+	var userID string
+	if err := m.PgClient.QueryRow(query, arg).Scan(&userID); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.PgClient.Query(`SELECT id, user_id, previous_role, reason, COALESCE(notes, ''), COALESCE(issued_by::text, ''),
+		starts_at, expires_at, lifted_at, COALESCE(lifted_by::text, ''), COALESCE(lift_reason, '')
+		FROM user_bans WHERE user_id = $1 ORDER BY starts_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bans := []models.UserBan{}
+	for rows.Next() {
+		var ban models.UserBan
+		err := rows.Scan(&ban.ID, &ban.UserID, &ban.PreviousRole, &ban.Reason, &ban.Notes, &ban.IssuedBy,
+			&ban.StartsAt, &ban.ExpiresAt, &ban.LiftedAt, &ban.LiftedBy, &ban.LiftReason)
+		if err != nil {
+			return nil, err
+		}
+		ban.Active = ban.LiftedAt == nil
+		bans = append(bans, ban)
+	}
+	return bans, rows.Err()
+}
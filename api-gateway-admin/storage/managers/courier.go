@@ -0,0 +1,176 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"gateway-admin/models"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Courier onboarding statuses.
+const (
+	CourierInvited            = "invited"
+	CourierDocumentsSubmitted = "documents_submitted"
+	CourierApproved           = "approved"
+	CourierActive             = "active"
+	CourierSuspended          = "suspended"
+)
+
+var (
+	ErrCourierNotFound = errors.New("courier not found")
+	ErrReasonRequired  = errors.New("reason is required")
+
+	ErrIllegalCourierStatus = errors.New("illegal courier status change")
+)
+
+// CourierReview is a status change an admin makes to a courier.
+type CourierReview struct {
+	From           []string
+	To             string
+	ReasonRequired bool
+}
+
+var (
+	ApproveCourier   = CourierReview{From: []string{CourierDocumentsSubmitted}, To: CourierApproved}
+	RejectCourier    = CourierReview{From: []string{CourierDocumentsSubmitted}, To: CourierInvited, ReasonRequired: true}
+	SuspendCourier   = CourierReview{From: []string{CourierApproved, CourierActive}, To: CourierSuspended, ReasonRequired: true}
+	ReinstateCourier = CourierReview{From: []string{CourierSuspended}, To: CourierActive}
+)
+
+const courierColumns = `p.user_id, u.email, p.full_name, p.phone, COALESCE(p.vehicle_type, ''), p.capacity_kg, p.status,
+	COALESCE(p.status_reason, ''), COALESCE(p.reviewed_by::text, ''), p.reviewed_at, p.submitted_at, p.created_at, p.updated_at,
+	o.user_id IS NOT NULL`
+
+// courierTables joins profiles with whether the courier is online right now.
+const courierTables = `courier_profiles p JOIN users u ON u.id = p.user_id LEFT JOIN online_couriers o ON o.user_id = p.user_id`
+
+func scanCourier(row interface{ Scan(...interface{}) error }) (*models.CourierProfile, error) {
+	var p models.CourierProfile
+	err := row.Scan(&p.UserID, &p.Email, &p.FullName, &p.Phone, &p.VehicleType, &p.CapacityKg, &p.Status,
+		&p.StatusReason, &p.ReviewedBy, &p.ReviewedAt, &p.SubmittedAt, &p.CreatedAt, &p.UpdatedAt, &p.Online)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListCouriers returns couriers, those waiting for review the longest first.
+// Online couriers are listed by how long they've been online, which is the
+// order dispatch should offer them deliveries in.
+func (m *UserManager) ListCouriers(req *models.ListCouriersReq) (*models.ListCouriersResp, error) {
+	res := &models.ListCouriersResp{Couriers: []models.CourierProfile{}, Limit: req.Limit, Offset: req.Offset}
+	where := `($1 = '' OR p.status = $1) AND (NOT $2 OR o.user_id IS NOT NULL)`
+	err := m.PgClient.QueryRow("SELECT COUNT(*) FROM "+courierTables+" WHERE "+where, req.Status, req.Online).Scan(&res.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.PgClient.Query(`SELECT `+courierColumns+` FROM `+courierTables+` WHERE `+where+`
+		ORDER BY o.online_since NULLS LAST, p.submitted_at NULLS LAST, p.created_at LIMIT $3 OFFSET $4`,
+		req.Status, req.Online, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		p, err := scanCourier(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Couriers = append(res.Couriers, *p)
+	}
+	return res, rows.Err()
+}
+
+// GetCourier returns the courier's profile with their documents.
+func (m *UserManager) GetCourier(userID string) (*models.CourierProfile, error) {
+	p, err := scanCourier(m.PgClient.QueryRow(`SELECT `+courierColumns+` FROM `+courierTables+` WHERE p.user_id = $1`, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCourierNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.PgClient.Query(`SELECT id, kind, object_key, content_type, size_bytes, uploaded_at
+		FROM courier_documents WHERE user_id = $1 ORDER BY kind`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	p.Documents = []models.CourierDocument{}
+	for rows.Next() {
+		var d models.CourierDocument
+		if err := rows.Scan(&d.ID, &d.Kind, &d.ObjectKey, &d.ContentType, &d.SizeBytes, &d.UploadedAt); err != nil {
+			return nil, err
+		}
+		p.Documents = append(p.Documents, d)
+	}
+	return p, rows.Err()
+}
+
+// ReviewCourier makes the review's status change if the courier's status
+// allows it. Suspending a courier also ends their shift.
+func (m *UserManager) ReviewCourier(review CourierReview, req *models.ReviewCourierReq) error {
+	if review.ReasonRequired && req.Reason == "" {
+		return ErrReasonRequired
+	}
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRow(`UPDATE courier_profiles p SET status = $2, status_reason = NULLIF($3, ''),
+		reviewed_by = $4, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		FROM courier_profiles old
+		WHERE p.user_id = $1 AND old.user_id = p.user_id AND old.status = ANY($5)
+		RETURNING old.status`,
+		req.UserID, review.To, req.Reason, req.ReviewedBy, pq.Array(review.From)).Scan(&status)
+	if err == nil {
+		if review.To == CourierSuspended {
+			_, err = tx.Exec(`UPDATE courier_shifts
+				SET online_seconds = online_seconds + COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - online_since)::bigint, 0),
+					online_since = NULL, ended_at = CURRENT_TIMESTAMP, end_reason = 'suspended'
+				WHERE user_id = $1 AND ended_at IS NULL`, req.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Commit()
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	err = tx.QueryRow("SELECT status FROM courier_profiles WHERE user_id = $1", req.UserID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCourierNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: courier is %s, not %s", ErrIllegalCourierStatus, status, strings.Join(review.From, " or "))
+}
+
+// OnlineCouriers reports which of the couriers are on shift and online.
+func (m *UserManager) OnlineCouriers(ids []string) (map[string]bool, error) {
+	rows, err := m.PgClient.Query("SELECT user_id FROM online_couriers WHERE user_id = ANY($1)", pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	online := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		online[id] = true
+	}
+	return online, rows.Err()
+}
@@ -0,0 +1,111 @@
+package managers
+
+import (
+	"context"
+	"encoding/json"
+	"gateway-admin/models"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis keys the courier gateway writes courier positions to.
+const CourierLocationsKey = "courier_locations" // Geo set of every courier's latest position
+
+// courierLocationKey holds the courier's latest point. It expires when the
+// courier stops reporting, which makes their geo set member stale.
+func courierLocationKey(userID string) string {
+	return "courier_location:" + userID
+}
+
+// deliveryTrackKey holds the breadcrumbs of an order's delivery, oldest first.
+func deliveryTrackKey(orderID string) string {
+	return "delivery_track:" + orderID
+}
+
+type LocationManager struct {
+	Redis *redis.Client
+}
+
+func NewLocationManager(rdb *redis.Client) *LocationManager {
+	return &LocationManager{Redis: rdb}
+}
+
+// Nearby returns couriers whose latest position is within radiusKm of the
+// point, closest first. Stale positions are skipped and dropped from the geo
+// set on the way.
+func (m *LocationManager) Nearby(lat, lng, radiusKm float64) ([]models.NearbyCourier, error) {
+	ctx := context.Background()
+	found, err := m.Redis.GeoSearchLocation(ctx, CourierLocationsKey, &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: redis.GeoSearchQuery{
+			Longitude:  lng,
+			Latitude:   lat,
+			Radius:     radiusKm,
+			RadiusUnit: "km",
+			Sort:       "ASC",
+		},
+		WithCoord: true,
+		WithDist:  true,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*redis.MapStringStringCmd, len(found))
+	_, err = m.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, loc := range found {
+			details[i] = pipe.HGetAll(ctx, courierLocationKey(loc.Name))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	couriers := []models.NearbyCourier{}
+	var stale []interface{}
+	for i, loc := range found {
+		values := details[i].Val()
+		if len(values) == 0 {
+			stale = append(stale, loc.Name)
+			continue
+		}
+		number := func(field string) float64 {
+			f, _ := strconv.ParseFloat(values[field], 64)
+			return f
+		}
+		ms, _ := strconv.ParseInt(values["recorded_at"], 10, 64)
+		couriers = append(couriers, models.NearbyCourier{
+			UserID:     loc.Name,
+			Lat:        loc.Latitude,
+			Lng:        loc.Longitude,
+			DistanceKm: loc.Dist,
+			AccuracyM:  number("accuracy_m"),
+			SpeedKmh:   number("speed_kmh"),
+			Heading:    number("heading"),
+			RecordedAt: time.UnixMilli(ms),
+		})
+	}
+	if len(stale) > 0 {
+		if err := m.Redis.ZRem(ctx, CourierLocationsKey, stale...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return couriers, nil
+}
+
+// Track returns the breadcrumbs kept for an order's delivery.
+func (m *LocationManager) Track(orderID string) ([]models.TrackPoint, error) {
+	crumbs, err := m.Redis.LRange(context.Background(), deliveryTrackKey(orderID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	points := make([]models.TrackPoint, len(crumbs))
+	for i, crumb := range crumbs {
+		if err := json.Unmarshal([]byte(crumb), &points[i]); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
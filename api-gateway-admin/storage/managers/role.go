@@ -0,0 +1,202 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"gateway-admin/models"
+	"regexp"
+
+	"github.com/lib/pq"
+)
+
+// RoleBanned is given to banned users by bans only, never assigned directly.
+const RoleBanned = "banned"
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleExists        = errors.New("role already exists")
+	ErrRoleBuiltin       = errors.New("built-in roles can't be deleted")
+	ErrRoleInUse         = errors.New("role is still assigned to users or recorded in their bans")
+	ErrInvalidRoleName   = errors.New("role name must be 2 to 64 lowercase letters, digits, '_' or '-'")
+	ErrAssignBanned      = errors.New("users are banned through /ban, not by role")
+	ErrUnknownPermission = errors.New("unknown permission")
+)
+
+var roleName = regexp.MustCompile(`^[a-z0-9_-]{2,64}$`)
+
+func (m *UserManager) ListPermissions() ([]models.Permission, error) {
+	rows, err := m.PgClient.Query("SELECT name, description FROM permissions ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	permissions := []models.Permission{}
+	for rows.Next() {
+		var p models.Permission
+		if err := rows.Scan(&p.Name, &p.Description); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, p)
+	}
+	return permissions, rows.Err()
+}
+
+func (m *UserManager) ListRoles() ([]models.Role, error) {
+	return m.roles("")
+}
+
+func (m *UserManager) GetRole(name string) (*models.Role, error) {
+	roles, err := m.roles(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, ErrRoleNotFound
+	}
+	return &roles[0], nil
+}
+
+// roles returns the role called name, or every role if name is empty.
+func (m *UserManager) roles(name string) ([]models.Role, error) {
+	rows, err := m.PgClient.Query(`SELECT r.name, r.description, r.builtin, r.two_factor_required, COALESCE(r.created_at, 'epoch'),
+		COALESCE(array_agg(p.permission ORDER BY p.permission) FILTER (WHERE p.permission IS NOT NULL), '{}')
+		FROM roles r LEFT JOIN role_permissions p ON p.role = r.name
+		WHERE $1 = '' OR r.name = $1
+		GROUP BY r.name ORDER BY r.builtin DESC, r.name`, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	roles := []models.Role{}
+	for rows.Next() {
+		var role models.Role
+		var permissions pq.StringArray
+		if err := rows.Scan(&role.Name, &role.Description, &role.Builtin, &role.TwoFactorRequired, &role.CreatedAt, &permissions); err != nil {
+			return nil, err
+		}
+		role.Permissions = permissions
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (m *UserManager) CreateRole(req *models.CreateRoleReq) error {
+	if !roleName.MatchString(req.Name) {
+		return ErrInvalidRoleName
+	}
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`INSERT INTO roles (name, description, two_factor_required) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO NOTHING`, req.Name, req.Description, req.TwoFactorRequired)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrRoleExists
+	}
+	if err := setPermissions(tx, req.Name, req.Permissions); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *UserManager) UpdateRole(req *models.UpdateRoleReq) error {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`UPDATE roles SET description = COALESCE($2, description),
+		two_factor_required = COALESCE($3, two_factor_required) WHERE name = $1`,
+		req.Name, req.Description, req.TwoFactorRequired)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrRoleNotFound
+	}
+	if req.Permissions != nil {
+		if _, err := tx.Exec("DELETE FROM role_permissions WHERE role = $1", req.Name); err != nil {
+			return err
+		}
+		if err := setPermissions(tx, req.Name, *req.Permissions); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func setPermissions(tx *sql.Tx, role string, permissions []string) error {
+	for _, permission := range permissions {
+		_, err := tx.Exec("INSERT INTO role_permissions (role, permission) VALUES ($1, $2) ON CONFLICT DO NOTHING", role, permission)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+			return fmt.Errorf("%w: %s", ErrUnknownPermission, permission)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteRole deletes a custom role nobody has or had before being banned.
+func (m *UserManager) DeleteRole(name string) error {
+	var builtin bool
+	err := m.PgClient.QueryRow("SELECT builtin FROM roles WHERE name = $1", name).Scan(&builtin)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrRoleNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if builtin {
+		return ErrRoleBuiltin
+	}
+	_, err = m.PgClient.Exec("DELETE FROM roles WHERE name = $1", name)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return ErrRoleInUse
+	}
+	return err
+}
+
+// AssignRole changes a user's role and returns the one they had. Banned users
+// keep theirs until the ban is lifted.
+func (m *UserManager) AssignRole(req *models.AssignRoleReq) (string, error) {
+	if req.Role == RoleBanned {
+		return "", ErrAssignBanned
+	}
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	_, previousRole, err := lockUser(tx, req.UserID, "")
+	if err != nil {
+		return "", err
+	}
+	if previousRole == RoleBanned {
+		return "", ErrAlreadyBanned
+	}
+	_, err = tx.Exec("UPDATE users SET role = $1 WHERE id = $2", req.Role, req.UserID)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return "", ErrRoleNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return previousRole, tx.Commit()
+}
@@ -1,10 +1,11 @@
 package managers
 
 import (
-	"auth-service/config"
-	"auth-service/models"
 	"database/sql"
 	"errors"
+	"fmt"
+	"gateway-admin/models"
+	"strings"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -33,122 +34,119 @@ func (m *UserManager) IsEmailExists(email string) error {
 	return nil
 }
 
-func (m *UserManager) BanUser(req models.BanUserReq) error {
-	var query string
-	if req.ID != "" {
-		query = "UPDATE users SET role = 'banned' WHERE id = $1 and role = 'user'"
-		res, err := m.PgClient.Exec(query, req.ID)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "user"); err != nil {
-			return err
-		}
-	} else if req.Email != "" {
-		query = "UPDATE users SET role = 'banned' WHERE email = $1 and role = 'user'"
-		res, err := m.PgClient.Exec(query, req.Email)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "user"); err != nil {
-			return err
-		}
-	}
-	return nil
+func (m *UserManager) AddCourier(courier *models.AddCourierReq) (*models.UserSnapshot, error) {
+	return m.addStaff(courier.Email, courier.Password, "courier")
 }
 
-func (m *UserManager) UnbanUser(req models.UnbanUserReq) error {
-	var query string
-	if req.ID != "" {
-		query = "UPDATE users SET role = 'user' WHERE id = $1 and role = 'banned'"
-		res, err := m.PgClient.Exec(query, req.ID)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "user"); err != nil {
-			return err
-		}
-	} else if req.Email != "" {
-		query = "UPDATE users SET role = 'user' WHERE email = $1 and role = 'banned'"
-		res, err := m.PgClient.Exec(query, req.Email)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "user"); err != nil {
-			return err
-		}
-	}
-	return nil
+func (m *UserManager) DeleteCourier(req models.DeleteCourierReq) (*models.UserSnapshot, error) {
+	return m.deleteStaff(req.ID, req.Email, "courier")
 }
 
-func (m *UserManager) AddCourier(courier *models.AddCourierReq) error {
-	query := "INSERT INTO users (id, email, password, role) VALUES ($1, $2, $3, $4)"
-	_, err := m.PgClient.Exec(query, uuid.NewString(), courier.Email, courier.Password, "courier")
+func (m *UserManager) AddProductManager(productManager *models.AddProductManagerReq) (*models.UserSnapshot, error) {
+	return m.addStaff(productManager.Email, productManager.Password, "manager")
+}
+
+func (m *UserManager) DeleteProductManager(req models.DeleteProductManagerReq) (*models.UserSnapshot, error) {
+	return m.deleteStaff(req.ID, req.Email, "manager")
+}
+
+// addStaff adds a user with the role. Couriers also get a profile, starting
+// their onboarding as invited.
+func (m *UserManager) addStaff(email, password, role string) (*models.UserSnapshot, error) {
+	tx, err := m.PgClient.Begin()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
-}
+	defer tx.Rollback()
 
-func (m *UserManager) DeleteCourier(req models.DeleteCourierReq) error {
-	var query string
-	if req.ID != "" {
-		query = "DELETE FROM users WHERE id = $1 and role = 'courier'"
-		if uuid.Validate(req.ID) != nil {
-			return errors.New("invalid user uuid")
-		}
-		res, err := m.PgClient.Exec(query, req.ID)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "courier"); err != nil {
-			return err
-		}
-	} else if req.Email != "" {
-		query = "DELETE FROM users WHERE email = $1 and role = 'courier'"
-		res, err := m.PgClient.Exec(query, req.Email)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "courier"); err != nil {
-			return err
+	user := &models.UserSnapshot{ID: uuid.NewString(), Email: email, Role: role}
+	query := "INSERT INTO users (id, email, password, role) VALUES ($1, $2, $3, $4)"
+	if _, err := tx.Exec(query, user.ID, user.Email, password, user.Role); err != nil {
+		return nil, err
+	}
+	if role == "courier" {
+		if _, err := tx.Exec("INSERT INTO courier_profiles (user_id) VALUES ($1)", user.ID); err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	return user, tx.Commit()
 }
 
-func (m *UserManager) AddProductManager(productManager *models.AddProductManagerReq) error {
-	query := "INSERT INTO users (id, email, password, role) VALUES ($1, $2, $3, $4)"
-	_, err := m.PgClient.Exec(query, uuid.NewString(), productManager.Email, productManager.Password, "manager")
+// deleteStaff deletes the user with the role by ID, or by email if id is
+// empty, and returns what was deleted.
+func (m *UserManager) deleteStaff(id, email, role string) (*models.UserSnapshot, error) {
+	query := "DELETE FROM users WHERE email = $1 and role = $2 RETURNING id, email, role"
+	arg := email
+	if id != "" {
+		if uuid.Validate(id) != nil {
+			return nil, errors.New("invalid user uuid")
+		}
+		query = "DELETE FROM users WHERE id = $1 and role = $2 RETURNING id, email, role"
+		arg = id
+	}
+	user := &models.UserSnapshot{}
+	err := m.PgClient.QueryRow(query, arg, role).Scan(&user.ID, &user.Email, &user.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New(role + " not found")
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return user, nil
 }
 
-func (m *UserManager) DeleteProductManager(req models.DeleteProductManagerReq) error {
-	var query string
-	if req.ID != "" {
-		query = "DELETE FROM users WHERE id = $1 and role = 'manager'"
-		if uuid.Validate(req.ID) != nil {
-			return errors.New("invalid user uuid")
-		}
-		res, err := m.PgClient.Exec(query, req.ID)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "manager"); err != nil {
-			return err
-		}
-	} else if req.Email != "" {
-		query = "DELETE FROM users WHERE email = $1 and role = 'manager'"
-		res, err := m.PgClient.Exec(query, req.Email)
-		if err != nil {
-			return err
-		}
-		if err := config.CheckRowsAffected(res, "manager"); err != nil {
-			return err
+// ListUsers returns the users matching req, newest first, along with how many
+// match in total. Deleted accounts are left out.
+func (m *UserManager) ListUsers(req *models.ListUsersReq) (*models.ListUsersResp, error) {
+	where := []string{"deleted_at IS NULL"}
+	args := []interface{}{}
+	filter := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if req.Role != "" {
+		filter("role = $%d", req.Role)
+	}
+	if req.IsConfirmed != nil {
+		filter("is_confirmed = $%d", *req.IsConfirmed)
+	}
+	if req.CreatedFrom != nil {
+		filter("created_at >= $%d", *req.CreatedFrom)
+	}
+	if req.CreatedTo != nil {
+		filter("created_at < $%d", *req.CreatedTo)
+	}
+	if req.Email != "" {
+		filter(`email ILIKE $%d ESCAPE '\'`, "%"+likeEscaper.Replace(req.Email)+"%")
+	}
+	conds := " WHERE " + strings.Join(where, " AND ")
+
+	res := &models.ListUsersResp{Users: []models.UserInfo{}, Limit: req.Limit, Offset: req.Offset}
+	if err := m.PgClient.QueryRow("SELECT COUNT(*) FROM users"+conds, args...).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, email, role, is_confirmed, COALESCE(created_at, 'epoch'), confirmed_at FROM users" + conds + " ORDER BY created_at DESC, id"
+	if req.Limit > 0 {
+		args = append(args, req.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	args = append(args, req.Offset)
+	query += fmt.Sprintf(" OFFSET $%d", len(args))
+
+	rows, err := m.PgClient.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var user models.UserInfo
+		if err := rows.Scan(&user.ID, &user.Email, &user.Role, &user.IsConfirmed, &user.CreatedAt, &user.ConfirmedAt); err != nil {
+			return nil, err
 		}
+		res.Users = append(res.Users, user)
 	}
-	return nil
+	return res, rows.Err()
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
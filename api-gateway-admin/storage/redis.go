@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"gateway-admin/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ConnectRedis connects to the Redis the courier gateway reports courier
+// positions to.
+func ConnectRedis(cf *config.Config) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cf.REDIS_ADDR,
+		Password: cf.REDIS_PASSWORD,
+		DB:       cf.REDIS_DB,
+	})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("redis not connected due to error: %s", err.Error())
+	}
+	return rdb, nil
+}
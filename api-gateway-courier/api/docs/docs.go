@@ -15,44 +15,56 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/add-courier": {
-            "post": {
+        "/deliveries": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a courier to the system. Only admins are allowed to use this function.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the orders the courier has delivered or is delivering, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courier"
+                    "delivery"
                 ],
-                "summary": "Add a courier",
+                "summary": "List deliveries",
                 "parameters": [
                     {
-                        "description": "Courier data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AddCourierReq"
-                        }
+                        "enum": [
+                            "picked_up",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Order status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Courier is added",
+                        "description": "Orders",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/genprotos.OrderGARes"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid pagination",
                         "schema": {
                             "type": "string"
                         }
@@ -66,44 +78,128 @@ const docTemplate = `{
                 }
             }
         },
-        "/add-product-manager": {
-            "post": {
+        "/deliveries/current": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a product-manager to the system. Only admins are allowed to use this function.",
-                "consumes": [
+                "description": "Gets the orders the courier has picked up and not yet delivered.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "delivery"
+                ],
+                "summary": "Get current deliveries",
+                "responses": {
+                    "200": {
+                        "description": "Orders",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGARes"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets an order the courier has delivered or is delivering, with its status timeline.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "product-manager"
+                    "delivery"
                 ],
-                "summary": "Add a product-manager",
+                "summary": "Get a delivery",
                 "parameters": [
                     {
-                        "description": "ProductManager data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery",
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.AddProductManagerReq"
+                            "type": "string"
                         }
                     }
+                }
+            }
+        },
+        "/deliveries/{id}/deliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks an order the courier carries as delivered. Requires a started shift, going offline doesn't stop a delivery in progress.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "delivery"
+                ],
+                "summary": "Deliver an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ProductManager is added",
+                        "description": "Order",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGRes"
+                        }
+                    },
+                    "403": {
+                        "description": "Order is assigned to another courier",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request payload",
+                    "404": {
+                        "description": "Order not found or no shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Order isn't picked up",
                         "schema": {
                             "type": "string"
                         }
@@ -117,14 +213,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/ban/{id}": {
-            "put": {
+        "/deliveries/{id}/fail": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Bans a user which doesn't allow user to use. Only admins are allowed to use this function.",
+                "description": "Marks an order the courier picked up, or is about to, as failed, e.g. when the customer can't be reached. Requires a started shift.",
                 "consumes": [
                     "application/json"
                 ],
@@ -132,38 +228,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "banning"
+                    "delivery"
                 ],
-                "summary": "Ban a user",
+                "summary": "Fail a delivery",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id or email of the user",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "email"
-                        ],
-                        "type": "string",
-                        "description": "Search with",
+                        "description": "Reason",
                         "name": "data",
-                        "in": "query",
-                        "required": true
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FailDeliveryReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User is banned",
+                        "description": "Order",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/genprotos.OrderGRes"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Reason is required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Order is assigned to another courier",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found or no shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Order can't fail anymore",
                         "schema": {
                             "type": "string"
                         }
@@ -177,53 +289,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/delete-courier/{id}": {
-            "delete": {
+        "/deliveries/{id}/pick-up": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a courier from the system. Only admins are allowed to use this function.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Takes an order that is ready for pickup and makes the courier its courier. Requires a shift the courier is online in.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courier"
+                    "delivery"
                 ],
-                "summary": "Delete a courier",
+                "summary": "Pick up an order",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id or email of the courier",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "id",
-                            "email"
-                        ],
-                        "type": "string",
-                        "description": "Search with",
-                        "name": "data",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Courier is deleted",
+                        "description": "Order",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGRes"
+                        }
+                    },
+                    "403": {
+                        "description": "Order is assigned to another courier",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request payload",
+                    "404": {
+                        "description": "Order not found or no shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Courier is offline or order isn't ready for pickup",
                         "schema": {
                             "type": "string"
                         }
@@ -237,53 +347,101 @@ const docTemplate = `{
                 }
             }
         },
-        "/delete-product-manager/{id}": {
-            "delete": {
+        "/documents": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a product-manager from the system. Only admins are allowed to use this function.",
-                "consumes": [
+                "description": "Lists the documents the courier uploaded. URLs are presigned and expire after a while.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "List own documents",
+                "responses": {
+                    "200": {
+                        "description": "Documents",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CourierDocument"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/documents/{kind}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Uploads a JPEG, PNG or PDF scan of a document, replacing any earlier upload of the same kind. Only possible before the profile is submitted, or after it was rejected.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "product-manager"
+                    "profile"
                 ],
-                "summary": "Delete a product-manager",
+                "summary": "Upload a document",
                 "parameters": [
                     {
+                        "enum": [
+                            "id_card",
+                            "driver_license",
+                            "vehicle_registration",
+                            "insurance"
+                        ],
                         "type": "string",
-                        "description": "id or email of the product-manager",
-                        "name": "id",
+                        "description": "Document kind",
+                        "name": "kind",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "email"
-                        ],
-                        "type": "string",
-                        "description": "Search with",
-                        "name": "data",
-                        "in": "query",
+                        "type": "file",
+                        "description": "Scan",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "ProductManager is deleted",
+                    "201": {
+                        "description": "Document",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.CourierDocument"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Profile is under review or approved",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
                         "schema": {
                             "type": "string"
                         }
@@ -297,14 +455,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/unban/{id}": {
-            "put": {
+        "/locations": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Unbans a user which allows user to use. Only admins are allowed to use this function.",
+                "description": "Reports the courier's positions, e.g. the ones the device buffered while offline. The newest becomes the courier's current position and all of them are added to the tracks of the orders they're carrying. Requires a started shift.",
                 "consumes": [
                     "application/json"
                 ],
@@ -312,38 +470,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "banning"
+                    "location"
                 ],
-                "summary": "Unban a user",
+                "summary": "Send locations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id or email of the user",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "id",
-                            "email"
-                        ],
-                        "type": "string",
-                        "description": "Search with",
+                        "description": "Points",
                         "name": "data",
-                        "in": "query",
-                        "required": true
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LocationBatchReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User is unbanned",
+                        "description": "Accepted points",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.LocationBatchResp"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid points",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
                         "schema": {
                             "type": "string"
                         }
@@ -356,27 +511,757 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.AddCourierReq": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string"
+        },
+        "/locations/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upgrades to a WebSocket the courier's device keeps sending positions over. Each message is a batch like the one POST /locations takes, answered with the same response or an error. The stream closes when the shift ends or after a minute without messages. Requires a started shift.",
+                "tags": [
+                    "location"
+                ],
+                "summary": "Stream locations",
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 }
             }
         },
-        "models.AddProductManagerReq": {
-            "type": "object",
-            "properties": {
-                "email": {
+        "/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets the courier's profile, uploaded documents and what's still missing before it can be submitted for review. Document URLs are presigned and expire after a while.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Get own profile",
+                "responses": {
+                    "200": {
+                        "description": "Profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourierProfile"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the courier's name, phone, vehicle and how much they can carry. Only possible before the profile is submitted, or after it was rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Fill in own profile",
+                "parameters": [
+                    {
+                        "description": "Profile",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateProfileReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourierProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid profile",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Profile is under review or approved",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/activate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts working once an admin approved the profile.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Activate profile",
+                "responses": {
+                    "200": {
+                        "description": "Profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourierProfile"
+                        }
+                    },
+                    "409": {
+                        "description": "Profile isn't approved",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a complete profile and its documents to an admin for review. Nothing can be changed until it's reviewed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Submit profile for review",
+                "responses": {
+                    "200": {
+                        "description": "Profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourierProfile"
+                        }
+                    },
+                    "409": {
+                        "description": "Profile is incomplete or already submitted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets the courier's open shift, whether they're online and how long they've worked and been online so far.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "Get current shift",
+                "responses": {
+                    "200": {
+                        "description": "Shift",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift/end": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends the courier's shift, taking them offline and forgetting their position. Not possible while they're carrying an order.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "End the shift",
+                "responses": {
+                    "200": {
+                        "description": "Shift",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Delivery in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift/offline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes the courier out of dispatch, e.g. for a break, without ending the shift. Deliveries in progress are unaffected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "Go offline",
+                "responses": {
+                    "200": {
+                        "description": "Shift",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift/online": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes the courier available to dispatch for the rest of the shift, or until they go offline.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "Go online",
+                "responses": {
+                    "200": {
+                        "description": "Shift",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "403": {
+                        "description": "Profile isn't active",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "No shift started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts a shift. The courier starts offline and has to go online to get deliveries. Only active couriers can work.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "Start a shift",
+                "responses": {
+                    "201": {
+                        "description": "Shift",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "403": {
+                        "description": "Profile isn't active",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Shift already started",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shifts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the courier's shifts with how long each lasted and how much of it they were online, newest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "List shifts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shifts",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListShiftsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "genprotos.OrderGARes": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderGRes"
+                    }
+                }
+            }
+        },
+        "genprotos.OrderGRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "courier_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderItem"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderItem": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "genprotos.OrderStatusEvent": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_role": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CourierDocument": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "uploaded_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Presigned, expires after a while",
+                    "type": "string"
+                }
+            }
+        },
+        "models.CourierProfile": {
+            "type": "object",
+            "properties": {
+                "capacity_kg": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourierDocument"
+                    }
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "missing": {
+                    "description": "What still has to be filled in or uploaded before submitting",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_reason": {
+                    "description": "Why the last submission was rejected or the courier suspended",
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "vehicle_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Delivery": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderStatusEvent"
+                    }
+                },
+                "order": {
+                    "$ref": "#/definitions/genprotos.OrderGRes"
+                }
+            }
+        },
+        "models.FailDeliveryReq": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ListShiftsResp": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Shift"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LocationBatchReq": {
+            "type": "object",
+            "properties": {
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LocationPoint"
+                    }
+                }
+            }
+        },
+        "models.LocationBatchResp": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "deliveries": {
+                    "description": "Orders the points were added to the track of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.LocationPoint": {
+            "type": "object",
+            "properties": {
+                "accuracy_m": {
+                    "description": "Radius of uncertainty in meters",
+                    "type": "number"
+                },
+                "heading": {
+                    "description": "Degrees clockwise from north",
+                    "type": "number"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lng": {
+                    "type": "number"
+                },
+                "recorded_at": {
+                    "type": "string"
+                },
+                "speed_kmh": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Shift": {
+            "type": "object",
+            "properties": {
+                "duration_seconds": {
+                    "type": "integer"
+                },
+                "end_reason": {
+                    "description": "courier, suspended or timeout",
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "online": {
+                    "type": "boolean"
+                },
+                "online_seconds": {
+                    "type": "integer"
+                },
+                "online_since": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.UpdateProfileReq": {
+            "type": "object",
+            "properties": {
+                "capacity_kg": {
+                    "type": "number"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "phone": {
                     "type": "string"
                 },
-                "password": {
+                "vehicle_type": {
+                    "description": "foot, bicycle, scooter or car",
                     "type": "string"
                 }
             }
@@ -397,7 +1282,7 @@ var SwaggerInfo = &swag.Spec{
 	Host:             "",
 	BasePath:         "/api/swagger/index.html#/",
 	Schemes:          []string{},
-	Title:            "Swaggers of admin panel",
+	Title:            "Swaggers of courier app",
 	Description:      "",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
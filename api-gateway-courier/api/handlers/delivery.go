@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"gateway-auth/middleware"
+	"gateway-courier/genprotos"
+	"gateway-courier/models"
+	"gateway-courier/storage/managers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CurrentDeliveries godoc
+// @Summary Get current deliveries
+// @Description Gets the orders the courier has picked up and not yet delivered.
+// @Tags delivery
+// @Produce json
+// @Success 200 {object} genprotos.OrderGARes "Orders"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries/current [get]
+func (h *HTTPHandler) CurrentDeliveries(c *gin.Context) {
+	res, err := h.OrderManager.ListOrders(context.Background(), &genprotos.OrderGAReq{CourierId: userID(c), Status: "picked_up"})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get deliveries": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ListDeliveries godoc
+// @Summary List deliveries
+// @Description Lists the orders the courier has delivered or is delivering, newest first.
+// @Tags delivery
+// @Produce json
+// @Param status query string false "Order status" Enums(picked_up, delivered, failed)
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param offset query int false "Offset"
+// @Success 200 {object} genprotos.OrderGARes "Orders"
+// @Failure 400 {object} string "Invalid pagination"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries [get]
+func (h *HTTPHandler) ListDeliveries(c *gin.Context) {
+	limit, offset, ok := pagination(c)
+	if !ok {
+		return
+	}
+	res, err := h.OrderManager.ListOrders(context.Background(), &genprotos.OrderGAReq{
+		CourierId:  userID(c),
+		Status:     c.Query("status"),
+		Pagination: &genprotos.Pagination{Limit: limit, Offset: offset},
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't list deliveries": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetDelivery godoc
+// @Summary Get a delivery
+// @Description Gets an order the courier has delivered or is delivering, with its status timeline.
+// @Tags delivery
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} models.Delivery "Delivery"
+// @Failure 404 {object} string "Delivery not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries/{id} [get]
+func (h *HTTPHandler) GetDelivery(c *gin.Context) {
+	order, err := h.OrderManager.GetOrder(context.Background(), &genprotos.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get delivery": status.Convert(err).Message()})
+		return
+	}
+	if order.CourierId != userID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "delivery not found"})
+		return
+	}
+	history, err := h.OrderManager.GetHistory(context.Background(), &genprotos.ByID{Id: order.Id})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't get delivery": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, models.Delivery{Order: order, History: history.Events})
+}
+
+// PickUpDelivery godoc
+// @Summary Pick up an order
+// @Description Takes an order that is ready for pickup and makes the courier its courier. Requires a shift the courier is online in.
+// @Tags delivery
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} genprotos.OrderGRes "Order"
+// @Failure 403 {object} string "Order is assigned to another courier"
+// @Failure 404 {object} string "Order not found or no shift started"
+// @Failure 409 {object} string "Courier is offline or order isn't ready for pickup"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries/{id}/pick-up [post]
+func (h *HTTPHandler) PickUpDelivery(c *gin.Context) {
+	shift, err := h.SS.Current(userID(c))
+	if err == nil && !shift.Online {
+		err = managers.ErrOffline
+	}
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't pick up order": err.Error()})
+		return
+	}
+	h.moveDelivery(c, "picked_up", "", "Couldn't pick up order")
+}
+
+// CompleteDelivery godoc
+// @Summary Deliver an order
+// @Description Marks an order the courier carries as delivered. Requires a started shift, going offline doesn't stop a delivery in progress.
+// @Tags delivery
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} genprotos.OrderGRes "Order"
+// @Failure 403 {object} string "Order is assigned to another courier"
+// @Failure 404 {object} string "Order not found or no shift started"
+// @Failure 409 {object} string "Order isn't picked up"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries/{id}/deliver [post]
+func (h *HTTPHandler) CompleteDelivery(c *gin.Context) {
+	if _, err := h.SS.Current(userID(c)); err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't deliver order": err.Error()})
+		return
+	}
+	h.moveDelivery(c, "delivered", "", "Couldn't deliver order")
+}
+
+// FailDelivery godoc
+// @Summary Fail a delivery
+// @Description Marks an order the courier picked up, or is about to, as failed, e.g. when the customer can't be reached. Requires a started shift.
+// @Tags delivery
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param data body models.FailDeliveryReq true "Reason"
+// @Success 200 {object} genprotos.OrderGRes "Order"
+// @Failure 400 {object} string "Reason is required"
+// @Failure 403 {object} string "Order is assigned to another courier"
+// @Failure 404 {object} string "Order not found or no shift started"
+// @Failure 409 {object} string "Order can't fail anymore"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /deliveries/{id}/fail [post]
+func (h *HTTPHandler) FailDelivery(c *gin.Context) {
+	var req models.FailDeliveryReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if req.Reason == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reason is required"})
+		return
+	}
+	if _, err := h.SS.Current(userID(c)); err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't fail delivery": err.Error()})
+		return
+	}
+	h.moveDelivery(c, "failed", req.Reason, "Couldn't fail delivery")
+}
+
+// moveDelivery moves the order in the path to another status as its courier.
+func (h *HTTPHandler) moveDelivery(c *gin.Context, to, reason, failure string) {
+	req := &genprotos.OrderStatusUReq{OrderId: c.Param("id"), Status: to, ActorKind: middleware.ActorCourier, Reason: reason}
+	req.ActorId, req.ActorRole = middleware.Actor(c)
+	res, err := h.OrderManager.UpdateStatus(context.Background(), req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{failure: status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,13 +1,32 @@
 package handlers
 
 import (
-	"auth-service/service"
+	"gateway-courier/genprotos"
+	"gateway-courier/service"
+	"gateway-courier/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
 )
 
 type HTTPHandler struct {
-	US *service.UserService
+	CS               *service.CourierService
+	SS               *service.ShiftService
+	LS               *service.LocationService
+	OrderManager     genprotos.OrderServiceClient
+	Documents        *storage.DocumentStore
+	DocMaxSize       int64
+	LocationBatchMax int
+}
+
+func NewHandler(cs *service.CourierService, ss *service.ShiftService, ls *service.LocationService, connO *grpc.ClientConn,
+	documents *storage.DocumentStore, docMaxSize int64, locationBatchMax int) *HTTPHandler {
+	return &HTTPHandler{CS: cs, SS: ss, LS: ls, OrderManager: genprotos.NewOrderServiceClient(connO),
+		Documents: documents, DocMaxSize: docMaxSize, LocationBatchMax: locationBatchMax}
 }
 
-func NewHandler(us *service.UserService) *HTTPHandler {
-	return &HTTPHandler{US: us}
+func userID(c *gin.Context) string {
+	id, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	return id
 }
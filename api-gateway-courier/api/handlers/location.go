@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"gateway-courier/genprotos"
+	"gateway-courier/models"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// A device can't be trusted to have an exact clock.
+	maxClockSkew = time.Minute
+	// streamIdleTimeout closes location streams that stopped sending.
+	streamIdleTimeout = time.Minute
+	// streamRecheck is how often a stream checks the courier is still on
+	// shift and which orders they're carrying.
+	streamRecheck = 15 * time.Second
+)
+
+var upgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
+
+// SendLocations godoc
+// @Summary Send locations
+// @Description Reports the courier's positions, e.g. the ones the device buffered while offline. The newest becomes the courier's current position and all of them are added to the tracks of the orders they're carrying. Requires a started shift.
+// @Tags location
+// @Accept json
+// @Produce json
+// @Param data body models.LocationBatchReq true "Points"
+// @Success 200 {object} models.LocationBatchResp "Accepted points"
+// @Failure 400 {object} string "Invalid points"
+// @Failure 404 {object} string "No shift started"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /locations [post]
+func (h *HTTPHandler) SendLocations(c *gin.Context) {
+	req := &models.LocationBatchReq{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if err := h.validPoints(req.Points); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	uid := userID(c)
+	if _, err := h.SS.Current(uid); err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't record locations": err.Error()})
+		return
+	}
+	deliveries, err := h.activeDeliveries(uid)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't check deliveries": status.Convert(err).Message()})
+		return
+	}
+	if err := h.LS.Record(uid, req.Points, deliveries); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't record locations": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.LocationBatchResp{Accepted: len(req.Points), Deliveries: deliveries})
+}
+
+// StreamLocations godoc
+// @Summary Stream locations
+// @Description Upgrades to a WebSocket the courier's device keeps sending positions over. Each message is a batch like the one POST /locations takes, answered with the same response or an error. The stream closes when the shift ends or after a minute without messages. Requires a started shift.
+// @Tags location
+// @Success 101 {object} string "Switching protocols"
+// @Failure 404 {object} string "No shift started"
+// @Security BearerAuth
+// @Router /locations/stream [get]
+func (h *HTTPHandler) StreamLocations(c *gin.Context) {
+	uid := userID(c)
+	if _, err := h.SS.Current(uid); err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't stream locations": err.Error()})
+		return
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return // The upgrader has already answered
+	}
+	defer conn.Close()
+	conn.SetReadLimit(64 << 10)
+
+	var (
+		deliveries []string
+		checked    time.Time
+	)
+	for {
+		conn.SetReadDeadline(time.Now().Add(streamIdleTimeout))
+		req := &models.LocationBatchReq{}
+		if err := conn.ReadJSON(req); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Printf("location stream of %s closed: %v", uid, err)
+			}
+			return
+		}
+		if err := h.validPoints(req.Points); err != nil {
+			conn.WriteJSON(gin.H{"error": err.Error()})
+			continue
+		}
+
+		if time.Since(checked) > streamRecheck {
+			if _, err := h.SS.Current(uid); err != nil {
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()))
+				return
+			}
+			if deliveries, err = h.activeDeliveries(uid); err != nil {
+				conn.WriteJSON(gin.H{"Couldn't check deliveries": status.Convert(err).Message()})
+				continue
+			}
+			checked = time.Now()
+		}
+		if err := h.LS.Record(uid, req.Points, deliveries); err != nil {
+			conn.WriteJSON(gin.H{"Couldn't record locations": err.Error()})
+			continue
+		}
+		if err := conn.WriteJSON(models.LocationBatchResp{Accepted: len(req.Points), Deliveries: deliveries}); err != nil {
+			return
+		}
+	}
+}
+
+func (h *HTTPHandler) validPoints(points []models.LocationPoint) error {
+	if len(points) == 0 || len(points) > h.LocationBatchMax {
+		return fmt.Errorf("a batch must have between 1 and %d points", h.LocationBatchMax)
+	}
+	for i, p := range points {
+		switch {
+		case p.Lat < -90 || p.Lat > 90:
+			return fmt.Errorf("points[%d]: lat must be between -90 and 90", i)
+		case p.Lng < -180 || p.Lng > 180:
+			return fmt.Errorf("points[%d]: lng must be between -180 and 180", i)
+		case p.AccuracyM < 0 || p.SpeedKmh < 0:
+			return fmt.Errorf("points[%d]: accuracy_m and speed_kmh must not be negative", i)
+		case p.Heading < 0 || p.Heading >= 360:
+			return fmt.Errorf("points[%d]: heading must be between 0 and 360", i)
+		case p.RecordedAt.IsZero() || p.RecordedAt.After(time.Now().Add(maxClockSkew)):
+			return fmt.Errorf("points[%d]: recorded_at is missing or in the future", i)
+		}
+	}
+	return nil
+}
+
+// activeDeliveries returns the IDs of the orders the courier is carrying.
+func (h *HTTPHandler) activeDeliveries(userID string) ([]string, error) {
+	res, err := h.OrderManager.ListOrders(context.Background(), &genprotos.OrderGAReq{CourierId: userID, Status: "picked_up"})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(res.Orders))
+	for i, order := range res.Orders {
+		ids[i] = order.Id
+	}
+	return ids, nil
+}
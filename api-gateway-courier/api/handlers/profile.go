@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"gateway-courier/config"
+	"gateway-courier/models"
+	"gateway-courier/storage/managers"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// documentTypes maps the content types accepted for documents to the
+// extension their objects are stored with.
+var documentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+// multipartOverhead is how much more than the document itself an upload's
+// body may hold, for the multipart boundaries and headers.
+const multipartOverhead = 64 << 10
+
+// GetProfile godoc
+// @Summary Get own profile
+// @Description Gets the courier's profile, uploaded documents and what's still missing before it can be submitted for review. Document URLs are presigned and expire after a while.
+// @Tags profile
+// @Produce json
+// @Success 200 {object} models.CourierProfile "Profile"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /profile [get]
+func (h *HTTPHandler) GetProfile(c *gin.Context) {
+	profile, err := h.CS.Profile(userID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get profile": err.Error()})
+		return
+	}
+	h.sign(profile.Documents)
+	c.JSON(http.StatusOK, profile)
+}
+
+// UpdateProfile godoc
+// @Summary Fill in own profile
+// @Description Sets the courier's name, phone, vehicle and how much they can carry. Only possible before the profile is submitted, or after it was rejected.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param data body models.UpdateProfileReq true "Profile"
+// @Success 200 {object} models.CourierProfile "Profile"
+// @Failure 400 {object} string "Invalid profile"
+// @Failure 409 {object} string "Profile is under review or approved"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /profile [put]
+func (h *HTTPHandler) UpdateProfile(c *gin.Context) {
+	req := &models.UpdateProfileReq{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if req.FullName == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "full_name is required"})
+		return
+	}
+	if !config.IsValidPhone(req.Phone) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid phone number"})
+		return
+	}
+	if !managers.VehicleTypes[req.VehicleType] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "vehicle_type must be one of foot, bicycle, scooter, car"})
+		return
+	}
+	if req.CapacityKg <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "capacity_kg must be positive"})
+		return
+	}
+	req.UserID = userID(c)
+
+	// Make sure the profile row exists for couriers added before profiles did
+	if _, err := h.CS.Profile(req.UserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get profile": err.Error()})
+		return
+	}
+	if err := h.CS.UpdateProfile(req); err != nil {
+		c.JSON(profileStatus(err), gin.H{"Couldn't update profile": err.Error()})
+		return
+	}
+	h.GetProfile(c)
+}
+
+// UploadDocument godoc
+// @Summary Upload a document
+// @Description Uploads a JPEG, PNG or PDF scan of a document, replacing any earlier upload of the same kind. Only possible before the profile is submitted, or after it was rejected.
+// @Tags profile
+// @Accept multipart/form-data
+// @Produce json
+// @Param kind path string true "Document kind" Enums(id_card, driver_license, vehicle_registration, insurance)
+// @Param file formData file true "Scan"
+// @Success 201 {object} models.CourierDocument "Document"
+// @Failure 400 {object} string "Invalid document"
+// @Failure 409 {object} string "Profile is under review or approved"
+// @Failure 413 {object} string "File too large"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /documents/{kind} [post]
+func (h *HTTPHandler) UploadDocument(c *gin.Context) {
+	kind := c.Param("kind")
+	if !managers.DocumentKinds[kind] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be one of id_card, driver_license, vehicle_registration, insurance"})
+		return
+	}
+	// Refuse oversized bodies while reading them, not after
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.DocMaxSize+multipartOverhead)
+	file, err := c.FormFile("file")
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("file must not be larger than %d bytes", h.DocMaxSize)})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if file.Size > h.DocMaxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("file must not be larger than %d bytes", h.DocMaxSize)})
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	defer f.Close()
+
+	// Trust the content, not the name or header the client sent
+	head := make([]byte, 512)
+	n, _ := f.Read(head)
+	contentType := http.DetectContentType(head[:n])
+	ext, ok := documentTypes[contentType]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file must be a JPEG, PNG or PDF"})
+		return
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't read file": err.Error()})
+		return
+	}
+
+	uid := userID(c)
+	profile, err := h.CS.Profile(uid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get profile": err.Error()})
+		return
+	}
+	if profile.Status != managers.CourierInvited {
+		c.JSON(http.StatusConflict, gin.H{"error": managers.ErrProfileLocked.Error()})
+		return
+	}
+
+	doc := &models.CourierDocument{
+		Kind:        kind,
+		ObjectKey:   fmt.Sprintf("couriers/%s/%s-%s%s", uid, kind, uuid.NewString(), ext),
+		ContentType: contentType,
+		SizeBytes:   file.Size,
+	}
+	if err := h.Documents.Put(doc.ObjectKey, f, file.Size, contentType); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't store document": err.Error()})
+		return
+	}
+	replaced, err := h.CS.SaveDocument(uid, doc)
+	if err != nil {
+		h.remove(doc.ObjectKey)
+		c.JSON(profileStatus(err), gin.H{"Couldn't save document": err.Error()})
+		return
+	}
+	if replaced != "" {
+		h.remove(replaced)
+	}
+	doc.URL, _ = h.Documents.URL(doc.ObjectKey)
+	c.JSON(http.StatusCreated, doc)
+}
+
+// ListDocuments godoc
+// @Summary List own documents
+// @Description Lists the documents the courier uploaded. URLs are presigned and expire after a while.
+// @Tags profile
+// @Produce json
+// @Success 200 {array} models.CourierDocument "Documents"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /documents [get]
+func (h *HTTPHandler) ListDocuments(c *gin.Context) {
+	profile, err := h.CS.Profile(userID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get documents": err.Error()})
+		return
+	}
+	h.sign(profile.Documents)
+	c.JSON(http.StatusOK, profile.Documents)
+}
+
+// SubmitProfile godoc
+// @Summary Submit profile for review
+// @Description Sends a complete profile and its documents to an admin for review. Nothing can be changed until it's reviewed.
+// @Tags profile
+// @Produce json
+// @Success 200 {object} models.CourierProfile "Profile"
+// @Failure 409 {object} string "Profile is incomplete or already submitted"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /profile/submit [post]
+func (h *HTTPHandler) SubmitProfile(c *gin.Context) {
+	if err := h.CS.Submit(userID(c)); err != nil {
+		c.JSON(profileStatus(err), gin.H{"Couldn't submit profile": err.Error()})
+		return
+	}
+	h.GetProfile(c)
+}
+
+// ActivateProfile godoc
+// @Summary Activate profile
+// @Description Starts working once an admin approved the profile.
+// @Tags profile
+// @Produce json
+// @Success 200 {object} models.CourierProfile "Profile"
+// @Failure 409 {object} string "Profile isn't approved"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /profile/activate [post]
+func (h *HTTPHandler) ActivateProfile(c *gin.Context) {
+	if err := h.CS.Activate(userID(c)); err != nil {
+		c.JSON(profileStatus(err), gin.H{"Couldn't activate profile": err.Error()})
+		return
+	}
+	h.GetProfile(c)
+}
+
+// sign fills in presigned links to the documents.
+func (h *HTTPHandler) sign(docs []models.CourierDocument) {
+	var err error
+	for i, doc := range docs {
+		docs[i].URL, err = h.Documents.URL(doc.ObjectKey)
+		if err != nil {
+			log.Printf("failed to sign a link to %s: %v", doc.ObjectKey, err)
+		}
+	}
+}
+
+func (h *HTTPHandler) remove(key string) {
+	if err := h.Documents.Remove(key); err != nil {
+		log.Printf("failed to remove document %s: %v", key, err)
+	}
+}
+
+func profileStatus(err error) int {
+	switch {
+	case errors.Is(err, managers.ErrProfileLocked), errors.Is(err, managers.ErrProfileIncomplete), errors.Is(err, managers.ErrNotApproved):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
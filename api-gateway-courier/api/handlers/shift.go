@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"gateway-courier/genprotos"
+	"gateway-courier/storage/managers"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+// GetShift godoc
+// @Summary Get current shift
+// @Description Gets the courier's open shift, whether they're online and how long they've worked and been online so far.
+// @Tags shift
+// @Produce json
+// @Success 200 {object} models.Shift "Shift"
+// @Failure 404 {object} string "No shift started"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shift [get]
+func (h *HTTPHandler) GetShift(c *gin.Context) {
+	shift, err := h.SS.Current(userID(c))
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't get shift": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// StartShift godoc
+// @Summary Start a shift
+// @Description Starts a shift. The courier starts offline and has to go online to get deliveries. Only active couriers can work.
+// @Tags shift
+// @Produce json
+// @Success 201 {object} models.Shift "Shift"
+// @Failure 403 {object} string "Profile isn't active"
+// @Failure 409 {object} string "Shift already started"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shift/start [post]
+func (h *HTTPHandler) StartShift(c *gin.Context) {
+	shift, err := h.SS.Start(userID(c))
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't start shift": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, shift)
+}
+
+// EndShift godoc
+// @Summary End the shift
+// @Description Ends the courier's shift, taking them offline and forgetting their position. Not possible while they're carrying an order.
+// @Tags shift
+// @Produce json
+// @Success 200 {object} models.Shift "Shift"
+// @Failure 404 {object} string "No shift started"
+// @Failure 409 {object} string "Delivery in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shift/end [post]
+func (h *HTTPHandler) EndShift(c *gin.Context) {
+	uid := userID(c)
+	current, err := h.OrderManager.ListOrders(context.Background(), &genprotos.OrderGAReq{
+		CourierId:  uid,
+		Status:     "picked_up",
+		Pagination: &genprotos.Pagination{Limit: 1},
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"Couldn't check deliveries": status.Convert(err).Message()})
+		return
+	}
+	if len(current.Orders) > 0 {
+		c.JSON(http.StatusConflict, gin.H{"error": managers.ErrShiftBlocked.Error()})
+		return
+	}
+
+	shift, err := h.SS.End(uid)
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't end shift": err.Error()})
+		return
+	}
+	if err := h.LS.Remove(uid); err != nil {
+		log.Printf("failed to remove location of %s: %v", uid, err)
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// GoOnline godoc
+// @Summary Go online
+// @Description Makes the courier available to dispatch for the rest of the shift, or until they go offline.
+// @Tags shift
+// @Produce json
+// @Success 200 {object} models.Shift "Shift"
+// @Failure 403 {object} string "Profile isn't active"
+// @Failure 404 {object} string "No shift started"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shift/online [post]
+func (h *HTTPHandler) GoOnline(c *gin.Context) {
+	shift, err := h.SS.GoOnline(userID(c))
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't go online": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// GoOffline godoc
+// @Summary Go offline
+// @Description Takes the courier out of dispatch, e.g. for a break, without ending the shift. Deliveries in progress are unaffected.
+// @Tags shift
+// @Produce json
+// @Success 200 {object} models.Shift "Shift"
+// @Failure 404 {object} string "No shift started"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shift/offline [post]
+func (h *HTTPHandler) GoOffline(c *gin.Context) {
+	shift, err := h.SS.GoOffline(userID(c))
+	if err != nil {
+		c.JSON(shiftStatus(err), gin.H{"Couldn't go offline": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// ListShifts godoc
+// @Summary List shifts
+// @Description Lists the courier's shifts with how long each lasted and how much of it they were online, newest first.
+// @Tags shift
+// @Produce json
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param offset query int false "Offset"
+// @Success 200 {object} models.ListShiftsResp "Shifts"
+// @Failure 400 {object} string "Invalid pagination"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /shifts [get]
+func (h *HTTPHandler) ListShifts(c *gin.Context) {
+	limit, offset, ok := pagination(c)
+	if !ok {
+		return
+	}
+	res, err := h.SS.List(userID(c), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't list shifts": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// pagination reads limit and offset from the query, answering 400 if they're
+// invalid.
+func pagination(c *gin.Context) (int64, int64, bool) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64)
+	if err != nil || limit < 1 || limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+		return 0, 0, false
+	}
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "offset must not be negative"})
+		return 0, 0, false
+	}
+	return limit, offset, true
+}
+
+func shiftStatus(err error) int {
+	switch {
+	case errors.Is(err, managers.ErrNotActive):
+		return http.StatusForbidden
+	case errors.Is(err, managers.ErrNoShift):
+		return http.StatusNotFound
+	case errors.Is(err, managers.ErrShiftOpen), errors.Is(err, managers.ErrOffline):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -7,32 +7,47 @@ import (
 
 	ginSwagger "github.com/swaggo/gin-swagger"
 
-	_ "auth-service/api/docs"
-	"auth-service/api/handlers"
-	"auth-service/api/middleware"
+	"gateway-auth/middleware"
+	_ "gateway-courier/api/docs"
+	"gateway-courier/api/handlers"
 )
 
-// @title Swaggers of admin panel
+// @title Swaggers of courier app
 // @version 1.0
 // @BasePath /api/swagger/index.html#/
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-func NewRouter(h *handlers.HTTPHandler) *gin.Engine {
+func NewRouter(h *handlers.HTTPHandler, auth *middleware.AuthClient) *gin.Engine {
 	router := gin.Default()
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	protected := router.Group("/", middleware.JWTMiddleware())
-	protected.Use(middleware.IsAdminMiddleware())
+	protected := router.Group("/", middleware.JWTMiddleware(auth), middleware.RequirePermission("courier:work"))
 
-	protected.PUT("/ban/:id", h.BanUser)
-	protected.PUT("/unban/:id", h.UnbanUser)
+	protected.GET("/profile", h.GetProfile)
+	protected.PUT("/profile", h.UpdateProfile)
+	protected.POST("/profile/submit", h.SubmitProfile)
+	protected.POST("/profile/activate", h.ActivateProfile)
 
-	protected.POST("/add-courier", h.AddCourier)
-	protected.DELETE("/delete-courier/:id", h.DeleteCourier)
+	protected.GET("/documents", h.ListDocuments)
+	protected.POST("/documents/:kind", h.UploadDocument)
 
-	protected.POST("/add-product-manager", h.AddProductManager)
-	protected.DELETE("/delete-product-manager/:id", h.DeleteProductManager)
+	protected.GET("/shift", h.GetShift)
+	protected.POST("/shift/start", h.StartShift)
+	protected.POST("/shift/end", h.EndShift)
+	protected.POST("/shift/online", h.GoOnline)
+	protected.POST("/shift/offline", h.GoOffline)
+	protected.GET("/shifts", h.ListShifts)
+
+	protected.POST("/locations", h.SendLocations)
+	protected.GET("/locations/stream", h.StreamLocations)
+
+	protected.GET("/deliveries", h.ListDeliveries)
+	protected.GET("/deliveries/current", h.CurrentDeliveries)
+	protected.GET("/deliveries/:id", h.GetDelivery)
+	protected.POST("/deliveries/:id/pick-up", h.PickUpDelivery)
+	protected.POST("/deliveries/:id/deliver", h.CompleteDelivery)
+	protected.POST("/deliveries/:id/fail", h.FailDelivery)
 
 	return router
 }
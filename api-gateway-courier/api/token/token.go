@@ -1,38 +0,0 @@
-package token
-
-import (
-	"errors"
-
-	"github.com/golang-jwt/jwt"
-)
-
-const (
-	signingKey = "mrbek"
-)
-
-func ValidateToken(tokenStr string) (bool, error) {
-	_, err := ExtractClaim(tokenStr)
-	if err != nil {
-		return false, err
-	}
-	return true, nil
-}
-
-func ExtractClaim(tokenStr string) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		return []byte(signingKey), nil
-	})
-	if err != nil {
-		return nil, errors.New("parsing token:" + err.Error())
-	}
-	if !token.Valid {
-		return nil, errors.New("invalid token")
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errors.New("invalid token claims")
-	}
-
-	return claims, nil
-}
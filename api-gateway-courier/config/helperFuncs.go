@@ -1,40 +1,14 @@
 package config
 
 import (
-	"database/sql"
 	"errors"
 	"regexp"
 
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 )
 
-func IsValidEmail(email string) bool {
-	return regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`).MatchString(email)
-}
-
-func IsValidPassword(password string) error {
-	if len(password) < 8 {
-		return errors.New("password must be at least 8 characters long")
-	}
-	if !regexp.MustCompile(`[0-9]`).MatchString(password) {
-		return errors.New("password must contain at least one number")
-	}
-	if !regexp.MustCompile(`[A-Z]`).MatchString(password) {
-		return errors.New("password must contain at least one uppercase letter")
-	}
-	if !regexp.MustCompile(`[!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]`).MatchString(password) {
-		return errors.New("password must contain at least one special character")
-	}
-	return nil
-}
-
-func HashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-	return string(hashedPassword), nil
+func IsValidPhone(phone string) bool {
+	return regexp.MustCompile(`^\+?[0-9]{9,15}$`).MatchString(phone)
 }
 
 func IsValidUUID(id string) error {
@@ -43,14 +17,3 @@ func IsValidUUID(id string) error {
 	}
 	return nil
 }
-
-func CheckRowsAffected(res sql.Result, object string) error {
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		return errors.New(object + " not found")
-	}
-	return nil
-}
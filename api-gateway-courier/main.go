@@ -1,24 +1,49 @@
 package main
 
 import (
+	"fmt"
+	"gateway-auth/middleware"
 	"gateway-courier/api"
 	"gateway-courier/api/handlers"
 	"gateway-courier/config"
+	"gateway-courier/service"
+	"gateway-courier/storage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	cf := config.Load()
 	em := config.NewErrorManager()
 
-	pgsql, mongo, err := storage.ConnectDB(&cf)
+	pgsql, err := storage.ConnectDB(&cf)
 	em.CheckErr(err)
 	defer pgsql.Close()
 
-	us := service.NewUserService(pgsql, mongo)
-	handler := handlers.NewHandler(us)
+	AuthConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.AUTH_GRPC_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer AuthConn.Close()
+
+	OrderConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.ORDER_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer OrderConn.Close()
+
+	documents, err := storage.ConnectDocuments(&cf)
+	em.CheckErr(err)
+
+	rdb, err := storage.ConnectRedis(&cf)
+	em.CheckErr(err)
+	defer rdb.Close()
+
+	cs := service.NewCourierService(pgsql)
+	ss := service.NewShiftService(pgsql)
+	go ss.CloseStaleShifts(cf.SHIFT_MAX_DURATION, cf.SHIFT_CHECK_EVERY)
+	ls := service.NewLocationService(rdb, cf.LOCATION_TTL, cf.TRACK_MAX_POINTS, cf.TRACK_TTL)
+	handler := handlers.NewHandler(cs, ss, ls, OrderConn, documents, cf.COURIER_DOC_MAX_SIZE, cf.LOCATION_BATCH_MAX)
 
-	roter := api.NewRouter(handler)
-	if err := roter.Run(cf.API_GATEWAY_ADMIN_PORT); err != nil {
+	roter := api.NewRouter(handler, middleware.NewAuthClient(AuthConn, cf.AUTH_CACHE_TTL))
+	if err := roter.Run(cf.API_GATEWAY_COURIER_PORT); err != nil {
 		panic(err)
 	}
 }
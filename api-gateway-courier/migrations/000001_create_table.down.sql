@@ -1,5 +0,0 @@
--- Drop the users table
-DROP TABLE IF EXISTS users;
-
--- Drop the enum type
-DROP TYPE IF EXISTS user_role;
\ No newline at end of file
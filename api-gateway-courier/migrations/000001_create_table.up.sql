@@ -1,19 +0,0 @@
--- Create the enum type for roles
-DO $$
-BEGIN
-    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'user_role') THEN
-        CREATE TYPE user_role AS ENUM ('admin', 'user', 'courier', 'manager', 'banned');
-    END IF;
-END
-$$;
-
--- Up migration
-CREATE TABLE users (
-    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    email VARCHAR(255) NOT NULL UNIQUE,
-    password TEXT NOT NULL,
-    role user_role NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    is_confirmed BOOLEAN NOT NULL DEFAULT FALSE, -- Add is_confirmed column
-    confirmed_at TIMESTAMP -- Optional: Add confirmed_at column
-);
\ No newline at end of file
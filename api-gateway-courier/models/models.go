@@ -1,31 +1,92 @@
 package models
 
-type BanUserReq struct {
-	ID    string `json:"id"`    // Username of the profile to retrieve
-	Email string `json:"email"` // Username of the profile to retrieve
+import (
+	"gateway-courier/genprotos"
+	"time"
+)
+
+type CourierProfile struct {
+	UserID       string            `json:"user_id"`
+	FullName     string            `json:"full_name"`
+	Phone        string            `json:"phone"`
+	VehicleType  string            `json:"vehicle_type"`
+	CapacityKg   float64           `json:"capacity_kg"`
+	Status       string            `json:"status"`
+	StatusReason string            `json:"status_reason,omitempty"` // Why the last submission was rejected or the courier suspended
+	SubmittedAt  *time.Time        `json:"submitted_at,omitempty"`
+	ReviewedAt   *time.Time        `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	Documents    []CourierDocument `json:"documents"`
+	Missing      []string          `json:"missing,omitempty"` // What still has to be filled in or uploaded before submitting
+}
+
+type UpdateProfileReq struct {
+	UserID      string  `json:"-"`
+	FullName    string  `json:"full_name"`
+	Phone       string  `json:"phone"`
+	VehicleType string  `json:"vehicle_type"` // foot, bicycle, scooter or car
+	CapacityKg  float64 `json:"capacity_kg"`
+}
+
+type CourierDocument struct {
+	ID          string    `json:"id"`
+	Kind        string    `json:"kind"`
+	ObjectKey   string    `json:"-"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+	URL         string    `json:"url,omitempty"` // Presigned, expires after a while
+}
+
+// Shift is a stretch of time a courier works. Durations are in seconds and
+// keep growing while the shift is open.
+type Shift struct {
+	ID              string     `json:"id"`
+	StartedAt       time.Time  `json:"started_at"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	EndReason       string     `json:"end_reason,omitempty"` // courier, suspended or timeout
+	Online          bool       `json:"online"`
+	OnlineSince     *time.Time `json:"online_since,omitempty"`
+	DurationSeconds int64      `json:"duration_seconds"`
+	OnlineSeconds   int64      `json:"online_seconds"`
+}
+
+type ListShiftsResp struct {
+	Shifts []Shift `json:"shifts"`
+	Total  int64   `json:"total"`
+	Limit  int64   `json:"limit"`
+	Offset int64   `json:"offset"`
 }
 
-type UnbanUserReq struct {
-	ID    string `json:"id"`    // Username of the profile to retrieve
-	Email string `json:"email"` // Username of the profile to retrieve
+// Delivery is an order the courier carries, with its status timeline.
+type Delivery struct {
+	Order   *genprotos.OrderGRes          `json:"order"`
+	History []*genprotos.OrderStatusEvent `json:"history"`
 }
 
-type AddCourierReq struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// FailDeliveryReq says why a delivery couldn't be completed.
+type FailDeliveryReq struct {
+	Reason string `json:"reason"`
 }
 
-type DeleteCourierReq struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+// LocationPoint is a position reported by the courier's device.
+type LocationPoint struct {
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	AccuracyM  float64   `json:"accuracy_m,omitempty"` // Radius of uncertainty in meters
+	SpeedKmh   float64   `json:"speed_kmh,omitempty"`
+	Heading    float64   `json:"heading,omitempty"` // Degrees clockwise from north
+	RecordedAt time.Time `json:"recorded_at"`
 }
 
-type AddProductManagerReq struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// LocationBatchReq carries points the device buffered, e.g. while it had no
+// connection. They don't have to be in order.
+type LocationBatchReq struct {
+	Points []LocationPoint `json:"points"`
 }
 
-type DeleteProductManagerReq struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+type LocationBatchResp struct {
+	Accepted   int      `json:"accepted"`
+	Deliveries []string `json:"deliveries"` // Orders the points were added to the track of
 }
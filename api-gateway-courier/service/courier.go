@@ -0,0 +1,35 @@
+package service
+
+import (
+	"database/sql"
+	"gateway-courier/models"
+	"gateway-courier/storage/managers"
+)
+
+type CourierService struct {
+	CM managers.CourierManager
+}
+
+func NewCourierService(db *sql.DB) *CourierService {
+	return &CourierService{CM: *managers.NewCourierManager(db)}
+}
+
+func (s *CourierService) Profile(userID string) (*models.CourierProfile, error) {
+	return s.CM.Profile(userID)
+}
+
+func (s *CourierService) UpdateProfile(req *models.UpdateProfileReq) error {
+	return s.CM.UpdateProfile(req)
+}
+
+func (s *CourierService) SaveDocument(userID string, doc *models.CourierDocument) (string, error) {
+	return s.CM.SaveDocument(userID, doc)
+}
+
+func (s *CourierService) Submit(userID string) error {
+	return s.CM.Submit(userID)
+}
+
+func (s *CourierService) Activate(userID string) error {
+	return s.CM.Activate(userID)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"gateway-courier/models"
+	"gateway-courier/storage/managers"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type LocationService struct {
+	LM managers.LocationManager
+}
+
+func NewLocationService(rdb *redis.Client, ttl time.Duration, trackMaxPoints int64, trackTTL time.Duration) *LocationService {
+	return &LocationService{LM: *managers.NewLocationManager(rdb, ttl, trackMaxPoints, trackTTL)}
+}
+
+func (s *LocationService) Record(userID string, points []models.LocationPoint, deliveries []string) error {
+	return s.LM.Record(userID, points, deliveries)
+}
+
+func (s *LocationService) Remove(userID string) error {
+	return s.LM.Remove(userID)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"database/sql"
+	"gateway-courier/models"
+	"gateway-courier/storage/managers"
+	"log"
+	"time"
+)
+
+type ShiftService struct {
+	SM managers.ShiftManager
+}
+
+func NewShiftService(db *sql.DB) *ShiftService {
+	return &ShiftService{SM: *managers.NewShiftManager(db)}
+}
+
+func (s *ShiftService) Current(userID string) (*models.Shift, error) {
+	return s.SM.Current(userID)
+}
+
+func (s *ShiftService) Start(userID string) (*models.Shift, error) {
+	return s.SM.Start(userID)
+}
+
+func (s *ShiftService) End(userID string) (*models.Shift, error) {
+	return s.SM.End(userID)
+}
+
+func (s *ShiftService) GoOnline(userID string) (*models.Shift, error) {
+	return s.SM.GoOnline(userID)
+}
+
+func (s *ShiftService) GoOffline(userID string) (*models.Shift, error) {
+	return s.SM.GoOffline(userID)
+}
+
+func (s *ShiftService) List(userID string, limit, offset int64) (*models.ListShiftsResp, error) {
+	return s.SM.List(userID, limit, offset)
+}
+
+// CloseStaleShifts ends shifts left open longer than maxAge, checking every
+// interval, so forgotten shifts don't keep couriers in dispatch.
+func (s *ShiftService) CloseStaleShifts(maxAge, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		n, err := s.SM.CloseStale(maxAge)
+		if err != nil {
+			log.Printf("failed to close stale shifts: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("closed %d stale shifts", n)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"gateway-courier/config"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// DocumentStore keeps the documents couriers upload in a private bucket and
+// shares them as short-lived presigned links.
+type DocumentStore struct {
+	Client *minio.Client
+	Bucket string
+	URLTTL time.Duration
+}
+
+// ConnectDocuments connects to MinIO and creates the bucket if it's missing.
+func ConnectDocuments(cf *config.Config) (*DocumentStore, error) {
+	client, err := minio.New(cf.MINIO_ENDPOINT, &minio.Options{
+		Creds:  credentials.NewStaticV4(cf.MINIO_ACCESS_KEY, cf.MINIO_SECRET_KEY, ""),
+		Secure: cf.MINIO_USE_SSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	exists, err := client.BucketExists(ctx, cf.COURIER_DOCS_BUCKET)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cf.COURIER_DOCS_BUCKET, minio.MakeBucketOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return &DocumentStore{Client: client, Bucket: cf.COURIER_DOCS_BUCKET, URLTTL: cf.COURIER_DOCS_URL_TTL}, nil
+}
+
+func (s *DocumentStore) Put(key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.Client.PutObject(context.Background(), s.Bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *DocumentStore) Remove(key string) error {
+	return s.Client.RemoveObject(context.Background(), s.Bucket, key, minio.RemoveObjectOptions{})
+}
+
+// URL returns a link to the object that works for URLTTL.
+func (s *DocumentStore) URL(key string) (string, error) {
+	u, err := s.Client.PresignedGetObject(context.Background(), s.Bucket, key, s.URLTTL, url.Values{})
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"gateway-courier/config"
+
+	_ "github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+)
+
+func ConnectDB(cf *config.Config) (*sql.DB, error) {
+	dbConn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", cf.DB_USER, cf.DB_PASSWORD, cf.DB_HOST, cf.DB_PORT, cf.DB_NAME)
+	db, err := sql.Open("postgres", dbConn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func ConnectRedis(cf *config.Config) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cf.REDIS_ADDR,
+		Password: cf.REDIS_PASSWORD,
+		DB:       cf.REDIS_DB,
+	})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("redis not connected due to error: %s", err.Error())
+	}
+	return rdb, nil
+}
@@ -0,0 +1,214 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"gateway-courier/models"
+)
+
+// Courier onboarding statuses.
+const (
+	CourierInvited            = "invited"
+	CourierDocumentsSubmitted = "documents_submitted"
+	CourierApproved           = "approved"
+	CourierActive             = "active"
+	CourierSuspended          = "suspended"
+)
+
+// Document kinds.
+const (
+	DocumentIDCard              = "id_card"
+	DocumentDriverLicense       = "driver_license"
+	DocumentVehicleRegistration = "vehicle_registration"
+	DocumentInsurance           = "insurance"
+)
+
+var VehicleTypes = map[string]bool{"foot": true, "bicycle": true, "scooter": true, "car": true}
+
+var DocumentKinds = map[string]bool{
+	DocumentIDCard:              true,
+	DocumentDriverLicense:       true,
+	DocumentVehicleRegistration: true,
+	DocumentInsurance:           true,
+}
+
+// RequiredDocuments are the documents a courier must upload before submitting
+// for review. Motorized vehicles need a license and registration on top of an
+// ID card; insurance is optional.
+func RequiredDocuments(vehicleType string) []string {
+	if vehicleType == "scooter" || vehicleType == "car" {
+		return []string{DocumentIDCard, DocumentDriverLicense, DocumentVehicleRegistration}
+	}
+	return []string{DocumentIDCard}
+}
+
+var (
+	ErrProfileLocked     = errors.New("profile can only be changed while invited, before submitting")
+	ErrProfileIncomplete = errors.New("profile is incomplete")
+	ErrNotApproved       = errors.New("profile isn't approved")
+)
+
+type CourierManager struct {
+	PgClient *sql.DB
+}
+
+func NewCourierManager(db *sql.DB) *CourierManager {
+	return &CourierManager{PgClient: db}
+}
+
+// Profile returns the courier's profile with their documents. Couriers added
+// before profiles existed get an empty one.
+func (m *CourierManager) Profile(userID string) (*models.CourierProfile, error) {
+	_, err := m.PgClient.Exec("INSERT INTO courier_profiles (user_id) VALUES ($1) ON CONFLICT DO NOTHING", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var p models.CourierProfile
+	err = m.PgClient.QueryRow(`SELECT user_id, full_name, phone, COALESCE(vehicle_type, ''), capacity_kg, status,
+		COALESCE(status_reason, ''), submitted_at, reviewed_at, created_at, updated_at
+		FROM courier_profiles WHERE user_id = $1`, userID).Scan(&p.UserID, &p.FullName, &p.Phone, &p.VehicleType,
+		&p.CapacityKg, &p.Status, &p.StatusReason, &p.SubmittedAt, &p.ReviewedAt, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.PgClient.Query(`SELECT id, kind, object_key, content_type, size_bytes, uploaded_at
+		FROM courier_documents WHERE user_id = $1 ORDER BY kind`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	p.Documents = []models.CourierDocument{}
+	for rows.Next() {
+		var d models.CourierDocument
+		if err := rows.Scan(&d.ID, &d.Kind, &d.ObjectKey, &d.ContentType, &d.SizeBytes, &d.UploadedAt); err != nil {
+			return nil, err
+		}
+		p.Documents = append(p.Documents, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	p.Missing = missing(&p)
+	return &p, nil
+}
+
+// missing lists what the courier still has to provide before submitting.
+func missing(p *models.CourierProfile) []string {
+	var missing []string
+	if p.FullName == "" {
+		missing = append(missing, "full_name")
+	}
+	if p.Phone == "" {
+		missing = append(missing, "phone")
+	}
+	if p.VehicleType == "" {
+		missing = append(missing, "vehicle_type")
+	}
+	if p.CapacityKg <= 0 {
+		missing = append(missing, "capacity_kg")
+	}
+	uploaded := map[string]bool{}
+	for _, d := range p.Documents {
+		uploaded[d.Kind] = true
+	}
+	for _, kind := range RequiredDocuments(p.VehicleType) {
+		if !uploaded[kind] {
+			missing = append(missing, kind)
+		}
+	}
+	return missing
+}
+
+func (m *CourierManager) UpdateProfile(req *models.UpdateProfileReq) error {
+	res, err := m.PgClient.Exec(`UPDATE courier_profiles SET full_name = $2, phone = $3, vehicle_type = $4, capacity_kg = $5,
+		updated_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND status = $6`,
+		req.UserID, req.FullName, req.Phone, req.VehicleType, req.CapacityKg, CourierInvited)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrProfileLocked
+	}
+	return nil
+}
+
+// SaveDocument records an uploaded document and returns the object key of
+// the one it replaces, if any, so it can be removed from storage.
+func (m *CourierManager) SaveDocument(userID string, doc *models.CourierDocument) (string, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRow("SELECT status FROM courier_profiles WHERE user_id = $1 FOR UPDATE", userID).Scan(&status)
+	if err != nil {
+		return "", err
+	}
+	if status != CourierInvited {
+		return "", ErrProfileLocked
+	}
+
+	var replaced string
+	err = tx.QueryRow("SELECT object_key FROM courier_documents WHERE user_id = $1 AND kind = $2", userID, doc.Kind).Scan(&replaced)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	err = tx.QueryRow(`INSERT INTO courier_documents (user_id, kind, object_key, content_type, size_bytes)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, kind) DO UPDATE SET id = gen_random_uuid(), object_key = EXCLUDED.object_key,
+			content_type = EXCLUDED.content_type, size_bytes = EXCLUDED.size_bytes, uploaded_at = CURRENT_TIMESTAMP
+		RETURNING id, uploaded_at`,
+		userID, doc.Kind, doc.ObjectKey, doc.ContentType, doc.SizeBytes).Scan(&doc.ID, &doc.UploadedAt)
+	if err != nil {
+		return "", err
+	}
+	return replaced, tx.Commit()
+}
+
+// Submit sends a complete profile for review.
+func (m *CourierManager) Submit(userID string) error {
+	p, err := m.Profile(userID)
+	if err != nil {
+		return err
+	}
+	if p.Status != CourierInvited {
+		return ErrProfileLocked
+	}
+	if len(p.Missing) > 0 {
+		return fmt.Errorf("%w, missing: %v", ErrProfileIncomplete, p.Missing)
+	}
+	res, err := m.PgClient.Exec(`UPDATE courier_profiles SET status = $2, status_reason = NULL,
+		submitted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND status = $3`,
+		userID, CourierDocumentsSubmitted, CourierInvited)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrProfileLocked
+	}
+	return nil
+}
+
+// Activate lets an approved courier start working.
+func (m *CourierManager) Activate(userID string) error {
+	res, err := m.PgClient.Exec(`UPDATE courier_profiles SET status = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND status = $3`, userID, CourierActive, CourierApproved)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrNotApproved
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package managers
+
+import (
+	"context"
+	"encoding/json"
+	"gateway-courier/models"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis keys shared with the admin gateway, which reads what couriers report.
+const (
+	// CourierLocationsKey is a geo set of every courier's latest position.
+	CourierLocationsKey = "courier_locations"
+)
+
+// courierLocationKey holds the courier's latest point. It expires when the
+// courier stops reporting, and readers treat geo set members without it as
+// stale.
+func courierLocationKey(userID string) string {
+	return "courier_location:" + userID
+}
+
+// deliveryTrackKey holds the breadcrumbs of an order's delivery, oldest first.
+func deliveryTrackKey(orderID string) string {
+	return "delivery_track:" + orderID
+}
+
+// setLatest stores a point as the courier's latest unless a newer one is
+// already there, since batches from a device that was offline can arrive
+// after live points.
+var setLatest = redis.NewScript(`
+local recorded = tonumber(redis.call('HGET', KEYS[1], 'recorded_at') or '0')
+if tonumber(ARGV[6]) < recorded then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'lat', ARGV[1], 'lng', ARGV[2], 'accuracy_m', ARGV[3], 'speed_kmh', ARGV[4], 'heading', ARGV[5], 'recorded_at', ARGV[6])
+redis.call('PEXPIRE', KEYS[1], ARGV[7])
+redis.call('GEOADD', KEYS[2], ARGV[2], ARGV[1], ARGV[8])
+return 1
+`)
+
+type LocationManager struct {
+	Redis          *redis.Client
+	TTL            time.Duration // How long a position counts as current
+	TrackMaxPoints int64
+	TrackTTL       time.Duration
+}
+
+func NewLocationManager(rdb *redis.Client, ttl time.Duration, trackMaxPoints int64, trackTTL time.Duration) *LocationManager {
+	return &LocationManager{Redis: rdb, TTL: ttl, TrackMaxPoints: trackMaxPoints, TrackTTL: trackTTL}
+}
+
+// Record updates the courier's position from the newest of the points and
+// adds all of them to the tracks of the deliveries they're carrying.
+func (m *LocationManager) Record(userID string, points []models.LocationPoint, deliveries []string) error {
+	if len(points) == 0 {
+		return nil
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].RecordedAt.Before(points[j].RecordedAt) })
+	ctx := context.Background()
+
+	latest := points[len(points)-1]
+	if time.Since(latest.RecordedAt) < m.TTL {
+		err := setLatest.Run(ctx, m.Redis, []string{courierLocationKey(userID), CourierLocationsKey},
+			strconv.FormatFloat(latest.Lat, 'f', -1, 64),
+			strconv.FormatFloat(latest.Lng, 'f', -1, 64),
+			strconv.FormatFloat(latest.AccuracyM, 'f', -1, 64),
+			strconv.FormatFloat(latest.SpeedKmh, 'f', -1, 64),
+			strconv.FormatFloat(latest.Heading, 'f', -1, 64),
+			latest.RecordedAt.UnixMilli(),
+			m.TTL.Milliseconds(),
+			userID).Err()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+	crumbs := make([]interface{}, len(points))
+	for i, p := range points {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		crumbs[i] = b
+	}
+	_, err := m.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range deliveries {
+			pipe.RPush(ctx, deliveryTrackKey(id), crumbs...)
+			pipe.LTrim(ctx, deliveryTrackKey(id), -m.TrackMaxPoints, -1)
+			pipe.Expire(ctx, deliveryTrackKey(id), m.TrackTTL)
+		}
+		return nil
+	})
+	return err
+}
+
+// Remove forgets the courier's position, so they stop showing up near anything.
+func (m *LocationManager) Remove(userID string) error {
+	ctx := context.Background()
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, CourierLocationsKey, userID)
+		pipe.Del(ctx, courierLocationKey(userID))
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,149 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"gateway-courier/models"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Why a shift ended.
+const (
+	ShiftEndedByCourier = "courier"
+	ShiftEndedSuspended = "suspended"
+	ShiftEndedTimeout   = "timeout"
+)
+
+var (
+	ErrNotActive    = errors.New("profile isn't active")
+	ErrShiftOpen    = errors.New("shift already started")
+	ErrNoShift      = errors.New("no shift started")
+	ErrShiftBlocked = errors.New("shift can't end while a delivery is in progress")
+	ErrOffline      = errors.New("courier is offline")
+)
+
+const shiftColumns = `id, started_at, ended_at, COALESCE(end_reason, ''), online_since,
+	EXTRACT(EPOCH FROM COALESCE(ended_at, CURRENT_TIMESTAMP) - started_at)::bigint,
+	online_seconds + COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - online_since)::bigint, 0)`
+
+// closeShift ends a shift, counting its current online stretch.
+const closeShift = `online_seconds = online_seconds + COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - online_since)::bigint, 0),
+	online_since = NULL, ended_at = CURRENT_TIMESTAMP`
+
+func scanShift(row interface{ Scan(...interface{}) error }) (*models.Shift, error) {
+	var s models.Shift
+	err := row.Scan(&s.ID, &s.StartedAt, &s.EndedAt, &s.EndReason, &s.OnlineSince, &s.DurationSeconds, &s.OnlineSeconds)
+	if err != nil {
+		return nil, err
+	}
+	s.Online = s.OnlineSince != nil
+	return &s, nil
+}
+
+type ShiftManager struct {
+	PgClient *sql.DB
+}
+
+func NewShiftManager(db *sql.DB) *ShiftManager {
+	return &ShiftManager{PgClient: db}
+}
+
+// Current returns the courier's open shift.
+func (m *ShiftManager) Current(userID string) (*models.Shift, error) {
+	s, err := scanShift(m.PgClient.QueryRow(`SELECT `+shiftColumns+`
+		FROM courier_shifts WHERE user_id = $1 AND ended_at IS NULL`, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNoShift
+	}
+	return s, err
+}
+
+// Start opens a shift for an active courier. They start offline.
+func (m *ShiftManager) Start(userID string) (*models.Shift, error) {
+	s, err := scanShift(m.PgClient.QueryRow(`INSERT INTO courier_shifts (user_id)
+		SELECT user_id FROM courier_profiles WHERE user_id = $1 AND status = $2
+		RETURNING `+shiftColumns, userID, CourierActive))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotActive
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, ErrShiftOpen
+	}
+	return s, err
+}
+
+// End closes the courier's open shift.
+func (m *ShiftManager) End(userID string) (*models.Shift, error) {
+	s, err := scanShift(m.PgClient.QueryRow(`UPDATE courier_shifts SET `+closeShift+`, end_reason = $2
+		WHERE user_id = $1 AND ended_at IS NULL RETURNING `+shiftColumns, userID, ShiftEndedByCourier))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNoShift
+	}
+	return s, err
+}
+
+// GoOnline makes the courier available to dispatch. Going online twice is
+// harmless.
+func (m *ShiftManager) GoOnline(userID string) (*models.Shift, error) {
+	s, err := scanShift(m.PgClient.QueryRow(`UPDATE courier_shifts s SET online_since = COALESCE(s.online_since, CURRENT_TIMESTAMP)
+		FROM courier_profiles p
+		WHERE s.user_id = $1 AND s.ended_at IS NULL AND p.user_id = s.user_id AND p.status = $2
+		RETURNING `+shiftColumns, userID, CourierActive))
+	if !errors.Is(err, sql.ErrNoRows) {
+		return s, err
+	}
+	if _, err := m.Current(userID); err != nil {
+		return nil, err
+	}
+	return nil, ErrNotActive
+}
+
+// GoOffline takes the courier out of dispatch without ending their shift.
+func (m *ShiftManager) GoOffline(userID string) (*models.Shift, error) {
+	s, err := scanShift(m.PgClient.QueryRow(`UPDATE courier_shifts
+		SET online_seconds = online_seconds + COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - online_since)::bigint, 0),
+			online_since = NULL
+		WHERE user_id = $1 AND ended_at IS NULL RETURNING `+shiftColumns, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNoShift
+	}
+	return s, err
+}
+
+// List returns the courier's shifts, newest first.
+func (m *ShiftManager) List(userID string, limit, offset int64) (*models.ListShiftsResp, error) {
+	res := &models.ListShiftsResp{Shifts: []models.Shift{}, Limit: limit, Offset: offset}
+	err := m.PgClient.QueryRow("SELECT COUNT(*) FROM courier_shifts WHERE user_id = $1", userID).Scan(&res.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.PgClient.Query(`SELECT `+shiftColumns+`
+		FROM courier_shifts WHERE user_id = $1 ORDER BY started_at DESC LIMIT $2 OFFSET $3`, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		s, err := scanShift(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Shifts = append(res.Shifts, *s)
+	}
+	return res, rows.Err()
+}
+
+// CloseStale ends shifts that have been open longer than maxAge, for couriers
+// who forgot to end them, and returns how many it closed.
+func (m *ShiftManager) CloseStale(maxAge time.Duration) (int64, error) {
+	res, err := m.PgClient.Exec(`UPDATE courier_shifts SET `+closeShift+`, end_reason = $2
+		WHERE ended_at IS NULL AND started_at < CURRENT_TIMESTAMP - make_interval(secs => $1)`, maxAge.Seconds(), ShiftEndedTimeout)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -22,7 +22,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a product to the system. Only admins are allowed to use this function.",
+                "description": "Adds a product to the system. Requires the product:write permission.",
                 "consumes": [
                     "multipart/mixed"
                 ],
@@ -46,9 +46,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product is added",
+                        "description": "ID of the added product",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/genprotos.ByID"
                         }
                     },
                     "400": {
@@ -126,6 +126,170 @@ const docTemplate = `{
                 }
             }
         },
+        "/get-order-history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets the status timeline of an order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get order status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order status history",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderHistoryRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets orders, optionally filtered by status, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orders",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGARes"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-product-price-history/{product_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets every price a product has had, oldest first. With \"at\" set, gets only the price that was in effect at that moment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product"
+                ],
+                "summary": "Get product price history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price history",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.PriceHistoryRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "No price at that time",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/get-product/{id}": {
             "get": {
                 "description": "Gets a product from the system.",
@@ -183,7 +347,12 @@ const docTemplate = `{
         },
         "/get-products": {
             "get": {
-                "description": "Gets all products from the system.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets products from the system, filtered and sorted by the query parameters. Price filters and sorts use the list price, before discounts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -194,22 +363,193 @@ const docTemplate = `{
                     "product"
                 ],
                 "summary": "Get all products",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Categories, repeated or comma separated",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sellers, repeated or comma separated",
+                        "name": "seller",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum rating",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum weight",
+                        "name": "min_weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum weight",
+                        "name": "max_weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products in stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Additional details filter as details[key]=value",
+                        "name": "details",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "price_asc",
+                            "price_desc",
+                            "rating",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count all matching products",
+                        "name": "include_total",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Product data",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/genprotos.ProductGARes"
+                            "$ref": "#/definitions/genprotos.ProductGARes"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL of the next page, rel=next"
+                            },
+                            "X-Total-Count": {
+                                "type": "int",
+                                "description": "Number of matching products, with include_total"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/update-order-status/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves an order to the next status. The caller acts as staff, who can confirm, prepare, mark ready for pickup, cancel or fail orders.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Update order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status and reason",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderStatusUReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated order",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGRes"
+                        }
+                    },
                     "400": {
                         "description": "Invalid request payload",
                         "schema": {
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Transition is not allowed for staff",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Illegal status transition",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -221,7 +561,7 @@ const docTemplate = `{
         },
         "/update-product-count/{product_id}": {
             "put": {
-                "description": "Updates the count of a product.",
+                "description": "Adds delta to the stock of a product, or takes it away if delta is negative. Stock that is reserved by checkouts can't be taken away.",
                 "consumes": [
                     "application/json"
                 ],
@@ -263,6 +603,18 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough unreserved stock",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -325,6 +677,70 @@ const docTemplate = `{
                 }
             }
         },
+        "/update-product-price/{product_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the price, currency and optional discount of a product. A discount is either a percent or an amount and may have a validity window. Every change is kept in the price history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product"
+                ],
+                "summary": "Update product price",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.ProductPriceUReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product price updated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/update-product-rating/{product_id}": {
             "put": {
                 "description": "Updates the rating of a product.",
@@ -449,6 +865,193 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "genprotos.ByID": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.Discount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderGARes": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderGRes"
+                    }
+                }
+            }
+        },
+        "genprotos.OrderGRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "courier_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderItem"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderHistoryRes": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderStatusEvent"
+                    }
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderItem": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "genprotos.OrderStatusEvent": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_role": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderStatusUReq": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_kind": {
+                    "description": "What the actor acts as, \"staff\", \"courier\" or \"customer\". Gateways set it\nfrom the permission they checked, and transitions are allowed by it.",
+                    "type": "string"
+                },
+                "actor_role": {
+                    "description": "Role of the actor, only recorded in the history.",
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.PriceEntry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "genprotos.PriceHistoryRes": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.PriceEntry"
+                    }
+                },
+                "product_id": {
+                    "type": "string"
+                }
+            }
+        },
         "genprotos.ProductCReqForSwagger": {
             "type": "object",
             "properties": {
@@ -464,12 +1067,21 @@ const docTemplate = `{
                 "count": {
                     "type": "number"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
                 "name": {
                     "type": "string"
                 },
+                "price": {
+                    "type": "number"
+                },
                 "seller": {
                     "type": "string"
                 },
@@ -481,7 +1093,7 @@ const docTemplate = `{
         "genprotos.ProductCountUReq": {
             "type": "object",
             "properties": {
-                "count": {
+                "delta": {
                     "type": "number"
                 },
                 "product_id": {
@@ -492,11 +1104,20 @@ const docTemplate = `{
         "genprotos.ProductGARes": {
             "type": "object",
             "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
                 "products": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/genprotos.ProductGRes"
                     }
+                },
+                "total_count": {
+                    "type": "integer"
                 }
             }
         },
@@ -515,9 +1136,18 @@ const docTemplate = `{
                 "count": {
                     "type": "number"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
+                "effective_price": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -527,6 +1157,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "price": {
+                    "type": "number"
+                },
                 "rating": {
                     "type": "number"
                 },
@@ -549,6 +1182,23 @@ const docTemplate = `{
                 }
             }
         },
+        "genprotos.ProductPriceUReq": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                }
+            }
+        },
         "genprotos.ProductRatingUReq": {
             "type": "object",
             "properties": {
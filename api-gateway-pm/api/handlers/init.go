@@ -4,6 +4,7 @@ import (
 	pb "gateway-admin/genprotos"
 
 	"gateway-admin/drivers"
+	"gateway-auth/audit"
 
 	"github.com/minio/minio-go/v7"
 	"google.golang.org/grpc"
@@ -11,16 +12,20 @@ import (
 
 type HTTPHandler struct {
 	ProductManager pb.ProductServiceClient
+	OrderManager   pb.OrderServiceClient
 	MinIO          *minio.Client
+	Audit          *audit.Recorder
 }
 
-func NewHandler(connP *grpc.ClientConn) *HTTPHandler {
+func NewHandler(connP, connO *grpc.ClientConn, audit *audit.Recorder) *HTTPHandler {
 	minioClient, err := drivers.MinIOConnect()
 	if err != nil {
 		panic(err)
 	}
 	return &HTTPHandler{
 		ProductManager: pb.NewProductServiceClient(connP),
+		OrderManager:   pb.NewOrderServiceClient(connO),
 		MinIO:          minioClient,
+		Audit:          audit,
 	}
 }
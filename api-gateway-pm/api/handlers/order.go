@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+	"gateway-admin/storage"
+	"gateway-auth/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOrders godoc
+// @Summary Get orders
+// @Description Gets orders, optionally filtered by status, newest first.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param status query string false "Order status"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} pb.OrderGARes "Orders"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-orders [GET]
+func (h *HTTPHandler) GetOrders(c *gin.Context) {
+	req := pb.OrderGAReq{
+		Status: c.Query("status"),
+		Pagination: &pb.Pagination{
+			Limit:  cast.ToInt64(c.DefaultQuery("limit", "10")),
+			Offset: cast.ToInt64(c.DefaultQuery("offset", "0")),
+		},
+	}
+
+	res, err := h.OrderManager.ListOrders(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get orders", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateOrderStatus godoc
+// @Summary Update order status
+// @Description Moves an order to the next status. The caller acts as staff, who can confirm, prepare, mark ready for pickup, cancel or fail orders.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param data body pb.OrderStatusUReq true "New status and reason"
+// @Success 200 {object} pb.OrderGRes "Updated order"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 403 {object} string "Transition is not allowed for staff"
+// @Failure 409 {object} string "Illegal status transition"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /update-order-status/{id} [PUT]
+func (h *HTTPHandler) UpdateOrderStatus(c *gin.Context) {
+	var req pb.OrderStatusUReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+		return
+	}
+	req.OrderId = c.Param("id")
+	req.ActorId, req.ActorRole = middleware.Actor(c)
+	req.ActorKind = middleware.ActorStaff
+
+	before, err := h.OrderManager.GetOrder(context.Background(), &pb.ByID{Id: req.OrderId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to update order status", "details": status.Convert(err).Message()})
+		return
+	}
+	res, err := h.OrderManager.UpdateStatus(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to update order status", "details": status.Convert(err).Message()})
+		return
+	}
+	h.Audit.Record(c, storage.AuditOrderStatusUpdated, "order", req.OrderId, before, res)
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetOrderHistory godoc
+// @Summary Get order status history
+// @Description Gets the status timeline of an order.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} pb.OrderHistoryRes "Order status history"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-order-history/{id} [GET]
+func (h *HTTPHandler) GetOrderHistory(c *gin.Context) {
+	res, err := h.OrderManager.GetHistory(context.Background(), &pb.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get order history", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
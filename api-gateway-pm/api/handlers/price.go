@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+	"gateway-admin/storage"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateProductPrice godoc
+// @Summary Update product price
+// @Description Sets the price, currency and optional discount of a product. A discount is either a percent or an amount and may have a validity window. Every change is kept in the price history.
+// @Tags product
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Param data body pb.ProductPriceUReq true "Price data"
+// @Success 200 {object} string "Product price updated"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "Product not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /update-product-price/{product_id} [PUT]
+func (h *HTTPHandler) UpdateProductPrice(c *gin.Context) {
+	var req pb.ProductPriceUReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+		return
+	}
+	req.ProductId = c.Param("product_id")
+
+	before, err := h.ProductManager.Get(context.Background(), &pb.ByID{Id: req.ProductId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to update product price", "details": status.Convert(err).Message()})
+		return
+	}
+	_, err = h.ProductManager.UpdatePrice(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to update product price", "details": status.Convert(err).Message()})
+		return
+	}
+	after, _ := h.ProductManager.Get(context.Background(), &pb.ByID{Id: req.ProductId})
+	h.Audit.Record(c, storage.AuditProductPriceUpdated, "product", req.ProductId, before, after)
+
+	c.JSON(http.StatusOK, "product price updated")
+}
+
+// GetProductPriceHistory godoc
+// @Summary Get product price history
+// @Description Gets every price a product has had, oldest first. With "at" set, gets only the price that was in effect at that moment.
+// @Tags product
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Param at query string false "RFC 3339 time"
+// @Success 200 {object} pb.PriceHistoryRes "Price history"
+// @Failure 400 {object} string "Invalid request"
+// @Failure 404 {object} string "No price at that time"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-product-price-history/{product_id} [GET]
+func (h *HTTPHandler) GetProductPriceHistory(c *gin.Context) {
+	res, err := h.ProductManager.GetPriceHistory(context.Background(), &pb.PriceHistoryReq{
+		ProductId: c.Param("product_id"),
+		At:        c.Query("at"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get product price history", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
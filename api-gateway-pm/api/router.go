@@ -9,6 +9,7 @@ import (
 
 	_ "gateway-admin/api/docs"
 	"gateway-admin/api/handlers"
+	"gateway-auth/middleware"
 )
 
 // @title Swaggers of Product manager
@@ -16,14 +17,21 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-func NewRouter(h *handlers.HTTPHandler) *gin.Engine {
+func NewRouter(h *handlers.HTTPHandler, auth *middleware.AuthClient) *gin.Engine {
 	router := gin.Default()
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// protected := router.Group("/", middleware.JWTMiddleware())
-	// protected.Use(middleware.IsProductManagerMiddleware())
+	manager := router.Group("/", middleware.JWTMiddleware(auth))
 
-	router.POST("/add-product", h.AddProduct)
+	manager.GET("/get-orders", middleware.RequirePermission("order:read"), h.GetOrders)
+	manager.PUT("/update-order-status/:id", middleware.RequirePermission("order:update"), h.UpdateOrderStatus)
+	manager.GET("/get-order-history/:id", middleware.RequirePermission("order:read"), h.GetOrderHistory)
+
+	products := manager.Group("/", middleware.RequirePermission("product:write"))
+	products.POST("/add-product", h.AddProduct)
+	products.GET("/get-products", h.GetAllProducts)
+	products.PUT("/update-product-price/:product_id", h.UpdateProductPrice)
+	products.GET("/get-product-price-history/:product_id", h.GetProductPriceHistory)
 
 	return router
 }
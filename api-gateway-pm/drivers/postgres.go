@@ -0,0 +1,23 @@
+package drivers
+
+import (
+	"database/sql"
+	"fmt"
+	"gateway-admin/config"
+
+	_ "github.com/lib/pq"
+)
+
+// PostgresConnect connects to auth-service's database, where the audit log is
+// kept.
+func PostgresConnect(cf *config.Config) (*sql.DB, error) {
+	dbConn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", cf.DB_USER, cf.DB_PASSWORD, cf.DB_HOST, cf.DB_PORT, cf.DB_NAME)
+	db, err := sql.Open("postgres", dbConn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
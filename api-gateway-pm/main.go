@@ -5,6 +5,9 @@ import (
 	"gateway-admin/api"
 	"gateway-admin/api/handlers"
 	"gateway-admin/config"
+	"gateway-admin/drivers"
+	"gateway-auth/audit"
+	"gateway-auth/middleware"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -18,9 +21,21 @@ func main() {
 	em.CheckErr(err)
 	defer ProductConn.Close()
 
-	handler := handlers.NewHandler(ProductConn)
+	AuthConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.AUTH_GRPC_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer AuthConn.Close()
+
+	OrderConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.ORDER_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer OrderConn.Close()
+
+	pgsql, err := drivers.PostgresConnect(&cf)
+	em.CheckErr(err)
+	defer pgsql.Close()
+
+	handler := handlers.NewHandler(ProductConn, OrderConn, audit.NewRecorder(pgsql, "pm"))
 
-	roter := api.NewRouter(handler)
+	roter := api.NewRouter(handler, middleware.NewAuthClient(AuthConn, cf.AUTH_CACHE_TTL))
 	if err := roter.Run(cf.API_GATEWAY_PM_PORT); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,8 @@
+package storage
+
+// Actions this gateway records in the audit log.
+const (
+	AuditProductCreated      = "product.create"
+	AuditProductPriceUpdated = "product.update_price"
+	AuditOrderStatusUpdated  = "order.update_status"
+)
@@ -22,7 +22,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a product to the system. Only admins are allowed to use this function.",
+                "description": "Adds a product to the system. Requires the product:write permission.",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -46,7 +46,7 @@ const docTemplate = `{
                     {
                         "type": "file",
                         "description": "Product image",
-                        "name": "file",
+                        "name": "image",
                         "in": "formData",
                         "required": true
                     }
@@ -73,6 +73,235 @@ const docTemplate = `{
                 }
             }
         },
+        "/add-to-cart": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a product to the cart of the authenticated user. Adding a product that is already in the cart increases its quantity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Add a product to the cart",
+                "parameters": [
+                    {
+                        "description": "Product and quantity",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddToCartReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cart",
+                        "schema": {
+                            "$ref": "#/definitions/models.CartRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or not enough stock",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Checkout in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/cancel-order/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancels an order of the authenticated user while it is still pending or confirmed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Cancel an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderCancelReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order is cancelled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Order can't be cancelled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/checkout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reserves stock for every cart line in one reservation, creates an order, commits the reservation and clears the cart. If any step before the commit fails, the reservation is released and the cart is left untouched.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Check out the cart",
+                "parameters": [
+                    {
+                        "description": "Delivery address",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CheckoutReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created order",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or empty cart",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough stock or checkout already in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/clear-cart": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes every product from the cart of the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Clear the cart",
+                "responses": {
+                    "200": {
+                        "description": "Cart is cleared",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Checkout in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/delete-product/{id}": {
             "delete": {
                 "security": [
@@ -133,6 +362,192 @@ const docTemplate = `{
                 }
             }
         },
+        "/get-cart": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets the cart of the authenticated user with current product data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Get the cart",
+                "responses": {
+                    "200": {
+                        "description": "Cart",
+                        "schema": {
+                            "$ref": "#/definitions/models.CartRes"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-order-history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets the status timeline of an order of the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get order status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order status history",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderHistoryRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-order/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets an order of the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order data",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gets orders of the authenticated user, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order"
+                ],
+                "summary": "Get orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orders",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.OrderGARes"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/get-product/{id}": {
             "get": {
                 "description": "Gets a product from the system.",
@@ -160,21 +575,283 @@ const docTemplate = `{
                             "email"
                         ],
                         "type": "string",
-                        "description": "Search with",
-                        "name": "data",
+                        "description": "Search with",
+                        "name": "data",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product data",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.ProductGRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/get-products": {
+            "get": {
+                "description": "Gets products from the system, filtered and sorted by the query parameters. Price filters and sorts use the list price, before discounts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product"
+                ],
+                "summary": "Get all products",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Categories, repeated or comma separated",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sellers, repeated or comma separated",
+                        "name": "seller",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum rating",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum weight",
+                        "name": "min_weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum weight",
+                        "name": "max_weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products in stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Additional details filter as details[key]=value",
+                        "name": "details",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "price_asc",
+                            "price_desc",
+                            "rating",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count all matching products",
+                        "name": "include_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product data",
+                        "schema": {
+                            "$ref": "#/definitions/genprotos.ProductGARes"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL of the next page, rel=next"
+                            },
+                            "X-Total-Count": {
+                                "type": "int",
+                                "description": "Number of matching products, with include_total"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/remove-from-cart/{product_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a product from the cart of the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Remove a product from the cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cart",
+                        "schema": {
+                            "$ref": "#/definitions/models.CartRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Product is not in the cart",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Checkout in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Full-text search over product name, category, description and details. Queries may be typed in Uzbek Latin or Cyrillic script. Results are ranked by relevance and come with highlighted snippets and counts by category and seller.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product"
+                ],
+                "summary": "Search products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Seller",
+                        "name": "seller",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product data",
+                        "description": "Search results",
                         "schema": {
-                            "$ref": "#/definitions/genprotos.ProductGRes"
+                            "$ref": "#/definitions/genprotos.SearchRes"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Empty query",
                         "schema": {
                             "type": "string"
                         }
@@ -188,9 +865,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/get-products": {
-            "get": {
-                "description": "Gets all products from the system.",
+        "/update-cart-item/{product_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the quantity of a product in the cart of the authenticated user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -198,21 +880,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product"
+                    "cart"
+                ],
+                "summary": "Change quantity of a cart item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New quantity",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCartItemReq"
+                        }
+                    }
                 ],
-                "summary": "Get all products",
                 "responses": {
                     "200": {
-                        "description": "Product data",
+                        "description": "Cart",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/genprotos.ProductGARes"
-                            }
+                            "$ref": "#/definitions/models.CartRes"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or not enough stock",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Checkout in progress",
                         "schema": {
                             "type": "string"
                         }
@@ -228,7 +937,7 @@ const docTemplate = `{
         },
         "/update-product-count/{product_id}": {
             "put": {
-                "description": "Updates the count of a product.",
+                "description": "Adds delta to the stock of a product, or takes it away if delta is negative. Stock that is reserved by checkouts can't be taken away.",
                 "consumes": [
                     "application/json"
                 ],
@@ -270,6 +979,18 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough unreserved stock",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -456,6 +1177,158 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "genprotos.Discount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.FacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderCancelReq": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_role": {
+                    "description": "Role of the user, recorded in the history.",
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderGARes": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderGRes"
+                    }
+                }
+            }
+        },
+        "genprotos.OrderGRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "courier_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderItem"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderHistoryRes": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.OrderStatusEvent"
+                    }
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "genprotos.OrderItem": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "genprotos.OrderStatusEvent": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_role": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
         "genprotos.ProductCReqForSwagger": {
             "type": "object",
             "properties": {
@@ -471,12 +1344,21 @@ const docTemplate = `{
                 "count": {
                     "type": "number"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
                 "name": {
                     "type": "string"
                 },
+                "price": {
+                    "type": "number"
+                },
                 "seller": {
                     "type": "string"
                 },
@@ -488,7 +1370,7 @@ const docTemplate = `{
         "genprotos.ProductCountUReq": {
             "type": "object",
             "properties": {
-                "count": {
+                "delta": {
                     "type": "number"
                 },
                 "product_id": {
@@ -499,11 +1381,20 @@ const docTemplate = `{
         "genprotos.ProductGARes": {
             "type": "object",
             "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
                 "products": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/genprotos.ProductGRes"
                     }
+                },
+                "total_count": {
+                    "type": "integer"
                 }
             }
         },
@@ -522,9 +1413,18 @@ const docTemplate = `{
                 "count": {
                     "type": "number"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "discount": {
+                    "$ref": "#/definitions/genprotos.Discount"
+                },
+                "effective_price": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -534,6 +1434,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "price": {
+                    "type": "number"
+                },
                 "rating": {
                     "type": "number"
                 },
@@ -595,6 +1498,108 @@ const docTemplate = `{
                     "type": "number"
                 }
             }
+        },
+        "genprotos.SearchHit": {
+            "type": "object",
+            "properties": {
+                "highlights": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "product": {
+                    "$ref": "#/definitions/genprotos.ProductGRes"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "genprotos.SearchRes": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.FacetCount"
+                    }
+                },
+                "hits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.SearchHit"
+                    }
+                },
+                "sellers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genprotos.FacetCount"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AddToCartReq": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CartItemRes": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "false when the product was removed or has less stock than requested",
+                    "type": "boolean"
+                },
+                "product": {
+                    "$ref": "#/definitions/genprotos.ProductGRes"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CartRes": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CartItemRes"
+                    }
+                },
+                "total_items": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CheckoutReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.UpdateCartItemReq": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+// AddToCart godoc
+// @Summary Add a product to the cart
+// @Description Adds a product to the cart of the authenticated user. Adding a product that is already in the cart increases its quantity.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param data body models.AddToCartReq true "Product and quantity"
+// @Success 200 {object} models.CartRes "Cart"
+// @Failure 400 {object} string "Invalid request payload or not enough stock"
+// @Failure 404 {object} string "Product not found"
+// @Failure 409 {object} string "Checkout in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /add-to-cart [post]
+func (h *HTTPHandler) AddToCart(c *gin.Context) {
+	var req models.AddToCartReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+		return
+	}
+	if req.Quantity <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "quantity must be positive"})
+		return
+	}
+
+	product, err := h.ProductManager.Get(context.Background(), &pb.ByID{Id: req.ProductID})
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "product not found", "details": status.Convert(err).Message()})
+		return
+	}
+
+	cart, err := h.CartManager.Get(userID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cart", "details": err.Error()})
+		return
+	}
+	inCart := 0
+	for _, item := range cart {
+		if item.ProductID == req.ProductID {
+			inCart = item.Quantity
+		}
+	}
+	if float64(inCart+req.Quantity) > product.Count {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("only %v items of this product are in stock", product.Count)})
+		return
+	}
+
+	if err := h.CartManager.AddItem(userID(c), req.ProductID, req.Quantity); err != nil {
+		c.JSON(cartStatus(err, http.StatusInternalServerError), gin.H{"error": "failed to add product to cart", "details": err.Error()})
+		return
+	}
+
+	h.GetCart(c)
+}
+
+// UpdateCartItem godoc
+// @Summary Change quantity of a cart item
+// @Description Sets the quantity of a product in the cart of the authenticated user.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Param data body models.UpdateCartItemReq true "New quantity"
+// @Success 200 {object} models.CartRes "Cart"
+// @Failure 400 {object} string "Invalid request payload or not enough stock"
+// @Failure 404 {object} string "Product not found"
+// @Failure 409 {object} string "Checkout in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /update-cart-item/{product_id} [put]
+func (h *HTTPHandler) UpdateCartItem(c *gin.Context) {
+	var req models.UpdateCartItemReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+		return
+	}
+	if req.Quantity <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "quantity must be positive, remove the item instead"})
+		return
+	}
+	productID := c.Param("product_id")
+
+	product, err := h.ProductManager.Get(context.Background(), &pb.ByID{Id: productID})
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "product not found", "details": status.Convert(err).Message()})
+		return
+	}
+	if float64(req.Quantity) > product.Count {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("only %v items of this product are in stock", product.Count)})
+		return
+	}
+
+	if err := h.CartManager.SetQuantity(userID(c), productID, req.Quantity); err != nil {
+		c.JSON(cartStatus(err, http.StatusNotFound), gin.H{"error": "failed to update cart item", "details": err.Error()})
+		return
+	}
+
+	h.GetCart(c)
+}
+
+// RemoveFromCart godoc
+// @Summary Remove a product from the cart
+// @Description Removes a product from the cart of the authenticated user.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Success 200 {object} models.CartRes "Cart"
+// @Failure 404 {object} string "Product is not in the cart"
+// @Failure 409 {object} string "Checkout in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /remove-from-cart/{product_id} [delete]
+func (h *HTTPHandler) RemoveFromCart(c *gin.Context) {
+	if err := h.CartManager.RemoveItem(userID(c), c.Param("product_id")); err != nil {
+		c.JSON(cartStatus(err, http.StatusNotFound), gin.H{"error": "failed to remove product from cart", "details": err.Error()})
+		return
+	}
+
+	h.GetCart(c)
+}
+
+// ClearCart godoc
+// @Summary Clear the cart
+// @Description Removes every product from the cart of the authenticated user.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Success 200 {object} string "Cart is cleared"
+// @Failure 409 {object} string "Checkout in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /clear-cart [delete]
+func (h *HTTPHandler) ClearCart(c *gin.Context) {
+	if err := h.CartManager.Clear(userID(c)); err != nil {
+		c.JSON(cartStatus(err, http.StatusInternalServerError), gin.H{"error": "failed to clear cart", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, "cart is cleared")
+}
+
+// GetCart godoc
+// @Summary Get the cart
+// @Description Gets the cart of the authenticated user with current product data.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.CartRes "Cart"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-cart [get]
+func (h *HTTPHandler) GetCart(c *gin.Context) {
+	cart, err := h.CartManager.Get(userID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cart", "details": err.Error()})
+		return
+	}
+
+	res := models.CartRes{Items: []models.CartItemRes{}}
+	for _, item := range cart {
+		line := models.CartItemRes{ProductID: item.ProductID, Quantity: item.Quantity}
+		product, err := h.ProductManager.Get(context.Background(), &pb.ByID{Id: item.ProductID})
+		if err == nil {
+			line.Product = product
+			line.Available = float64(item.Quantity) <= product.Count
+		}
+		res.Items = append(res.Items, line)
+		res.TotalItems += item.Quantity
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// cartStatus answers 409 for cart writes made while the cart is being checked
+// out.
+func cartStatus(err error, fallback int) int {
+	if errors.Is(err, managers.ErrCheckoutInProgress) {
+		return http.StatusConflict
+	}
+	return fallback
+}
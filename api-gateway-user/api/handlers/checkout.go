@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+	"gateway-admin/models"
+	"gateway-admin/storage/managers"
+	"gateway-auth/middleware"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+// Checkout godoc
+// @Summary Check out the cart
+// @Description Reserves stock for every cart line in one reservation, creates an order, commits the reservation and clears the cart. If any step before the commit fails, the reservation is released and the cart is left untouched.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param data body models.CheckoutReq true "Delivery address"
+// @Success 201 {object} pb.OrderGRes "Created order"
+// @Failure 400 {object} string "Invalid request payload or empty cart"
+// @Failure 404 {object} string "Product not found"
+// @Failure 409 {object} string "Not enough stock or checkout already in progress"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /checkout [post]
+func (h *HTTPHandler) Checkout(c *gin.Context) {
+	var req models.CheckoutReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+		return
+	}
+	if req.Address == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "address is required"})
+		return
+	}
+	user, role := middleware.Actor(c)
+
+	if err := h.CartManager.Lock(user); err != nil {
+		if errors.Is(err, managers.ErrCheckoutInProgress) {
+			c.JSON(http.StatusConflict, gin.H{"error": "failed to check out", "details": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check out", "details": err.Error()})
+		return
+	}
+
+	cart, err := h.CartManager.Get(user)
+	if err != nil {
+		h.unlockCart(user)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cart", "details": err.Error()})
+		return
+	}
+	if len(cart) == 0 {
+		h.unlockCart(user)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cart is empty"})
+		return
+	}
+
+	ctx := context.Background()
+	stockReq := pb.StockBatchReq{}
+	orderReq := pb.OrderCReq{UserId: user, UserRole: role, Address: req.Address}
+	for _, item := range cart {
+		stockReq.Items = append(stockReq.Items, &pb.StockReq{ProductId: item.ProductID, Quantity: int64(item.Quantity)})
+		orderReq.Items = append(orderReq.Items, &pb.OrderItemCReq{ProductId: item.ProductID, Quantity: int64(item.Quantity)})
+	}
+
+	reservation, err := h.ProductManager.ReserveStockBatch(ctx, &stockReq)
+	if err != nil {
+		h.unlockCart(user)
+		c.JSON(httpStatus(err), gin.H{"error": "failed to reserve stock", "details": status.Convert(err).Message()})
+		return
+	}
+
+	orderReq.ReservationId = reservation.ReservationId
+	order, err := h.OrderManager.CreateOrder(ctx, &orderReq)
+	if err != nil {
+		h.releaseStock(reservation.ReservationId)
+		h.unlockCart(user)
+		c.JSON(httpStatus(err), gin.H{"error": "failed to create order", "details": status.Convert(err).Message()})
+		return
+	}
+
+	if _, err := h.ProductManager.CommitStock(ctx, &pb.ReservationReq{ReservationId: reservation.ReservationId}); err != nil {
+		_, cancelErr := h.OrderManager.CancelOrder(ctx, &pb.OrderCancelReq{Id: order.Id, UserId: user, UserRole: role, Reason: "stock reservation could not be committed"})
+		if cancelErr != nil {
+			log.Printf("checkout: failed to cancel order %s: %v", order.Id, cancelErr)
+		}
+		h.releaseStock(reservation.ReservationId)
+		h.unlockCart(user)
+		c.JSON(httpStatus(err), gin.H{"error": "failed to commit stock", "details": status.Convert(err).Message()})
+		return
+	}
+
+	// The order is placed at this point; a cart that failed to clear is only
+	// an inconvenience, so it is logged rather than undoing the order.
+	if err := h.CartManager.ClearAndUnlock(user); err != nil {
+		log.Printf("checkout: failed to clear cart of user %s after order %s: %v", user, order.Id, err)
+		h.unlockCart(user)
+	}
+
+	c.JSON(http.StatusCreated, order)
+}
+
+// releaseStock gives back a reservation. Failures are only logged: the
+// checkout is already failing, and product-service returns the stock once
+// the reservation expires anyway.
+func (h *HTTPHandler) releaseStock(reservationID string) {
+	_, err := h.ProductManager.ReleaseStock(context.Background(), &pb.ReservationReq{ReservationId: reservationID})
+	if err != nil {
+		log.Printf("checkout: failed to release stock reservation %s: %v", reservationID, err)
+	}
+}
+
+func (h *HTTPHandler) unlockCart(userID string) {
+	if err := h.CartManager.Unlock(userID); err != nil {
+		log.Printf("checkout: failed to unlock cart of user %s: %v", userID, err)
+	}
+}
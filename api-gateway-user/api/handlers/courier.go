@@ -10,11 +10,12 @@ import (
 	pb "gateway-admin/genprotos"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 )
 
 // AddProduct godoc
 // @Summary Add a product
-// @Description Adds a product to the system. Only admins are allowed to use this function.
+// @Description Adds a product to the system. Requires the product:write permission.
 // @Tags product
 // @Accept multipart/form-data
 // @Produce json
@@ -153,21 +154,43 @@ func (h *HTTPHandler) GetProduct(c *gin.Context) {
 
 // GetProducts godoc
 // @Summary Get all products
-// @Description Gets all products from the system.
+// @Description Gets products from the system, filtered and sorted by the query parameters. Price filters and sorts use the list price, before discounts.
 // @Tags product
 // @Accept json
 // @Produce json
-// @Success 200 {array} pb.ProductGARes "Product data"
-// @Failure 400 {object} string "Invalid request payload"
+// @Param category query []string false "Categories, repeated or comma separated" collectionFormat(multi)
+// @Param seller query []string false "Sellers, repeated or comma separated" collectionFormat(multi)
+// @Param min_price query number false "Minimum price"
+// @Param max_price query number false "Maximum price"
+// @Param min_rating query number false "Minimum rating"
+// @Param min_weight query number false "Minimum weight"
+// @Param max_weight query number false "Maximum weight"
+// @Param in_stock query bool false "Only products in stock"
+// @Param details query string false "Additional details filter as details[key]=value"
+// @Param sort query string false "Sort order" Enums(price_asc, price_desc, rating, newest)
+// @Param limit query int false "Page size, at most 100"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param include_total query bool false "Count all matching products"
+// @Success 200 {object} pb.ProductGARes "Product data"
+// @Header 200 {string} Link "URL of the next page, rel=next"
+// @Header 200 {int} X-Total-Count "Number of matching products, with include_total"
+// @Failure 400 {object} string "Invalid filter"
 // @Failure 500 {object} string "Server error"
 // @Router /get-products [get]
 func (h *HTTPHandler) GetAllProducts(c *gin.Context) {
-	res, err := h.ProductManager.GetAll(context.Background(), &pb.ProductGAReq{})
+	req, err := productsRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid filter", "details": err.Error()})
+		return
+	}
+
+	res, err := h.ProductManager.GetAll(context.Background(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, "failed to get products")
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get products", "details": status.Convert(err).Message()})
 		return
 	}
 
+	setPageHeaders(c, res, req.IncludeTotal)
 	c.JSON(http.StatusOK, res)
 }
 
@@ -203,7 +226,7 @@ func (h *HTTPHandler) UpdateProductRating(c *gin.Context) {
 
 // UpdateProductCount godoc
 // @Summary Update product count
-// @Description Updates the count of a product.
+// @Description Adds delta to the stock of a product, or takes it away if delta is negative. Stock that is reserved by checkouts can't be taken away.
 // @Tags product
 // @Accept json
 // @Produce json
@@ -211,6 +234,8 @@ func (h *HTTPHandler) UpdateProductRating(c *gin.Context) {
 // @Param data body pb.ProductCountUReq true "Count data"
 // @Success 200 {object} string "Product count updated"
 // @Failure 400 {object} string "Invalid request payload"
+// @Failure 404 {object} string "Product not found"
+// @Failure 409 {object} string "Not enough unreserved stock"
 // @Failure 500 {object} string "Server error"
 // @Router /update-product-count/{product_id} [put]
 func (h *HTTPHandler) UpdateProductCount(c *gin.Context) {
@@ -224,7 +249,7 @@ func (h *HTTPHandler) UpdateProductCount(c *gin.Context) {
 
 	_, err := h.ProductManager.UpdateCount(context.Background(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, "failed to update product count")
+		c.JSON(httpStatus(err), gin.H{"error": "failed to update product count", "details": status.Convert(err).Message()})
 		return
 	}
 
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "gateway-admin/genprotos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+// productsRequest reads the /get-products query parameters. Categories and
+// sellers may be repeated or comma separated, details are given as
+// details[key]=value, and pages are addressed with the cursor of the previous
+// page.
+func productsRequest(c *gin.Context) (*pb.ProductGAReq, error) {
+	req := &pb.ProductGAReq{
+		Categories: queryList(c, "category"),
+		Sellers:    queryList(c, "seller"),
+		Details:    c.QueryMap("details"),
+		Sort:       c.Query("sort"),
+		Cursor:     c.Query("cursor"),
+		Pagination: &pb.Pagination{
+			Limit: cast.ToInt64(c.DefaultQuery("limit", "10")),
+		},
+	}
+
+	var err error
+	for param, dst := range map[string]**float64{
+		"min_price":  &req.MinPrice,
+		"max_price":  &req.MaxPrice,
+		"min_rating": &req.MinRating,
+		"min_weight": &req.MinWeight,
+		"max_weight": &req.MaxWeight,
+	} {
+		if *dst, err = queryFloat(c, param); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.Query("in_stock"); v != "" {
+		if req.InStock, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("in_stock must be true or false")
+		}
+	}
+	if v := c.Query("include_total"); v != "" {
+		if req.IncludeTotal, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("include_total must be true or false")
+		}
+	}
+	return req, nil
+}
+
+// setPageHeaders points the client to the next page with a Link header, built
+// from the current URL so every filter carries over, and reports the total
+// when it was asked for.
+func setPageHeaders(c *gin.Context, res *pb.ProductGARes, includeTotal bool) {
+	if includeTotal {
+		c.Header("X-Total-Count", strconv.FormatInt(res.TotalCount, 10))
+	}
+	if !res.HasMore {
+		return
+	}
+	next := *c.Request.URL
+	query := next.Query()
+	query.Set("cursor", res.NextCursor)
+	next.RawQuery = query.Encode()
+	c.Header("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+}
+
+func queryList(c *gin.Context, key string) []string {
+	var res []string
+	for _, v := range c.QueryArray(key) {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+	return res
+}
+
+func queryFloat(c *gin.Context, key string) (*float64, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a number", key)
+	}
+	return &f, nil
+}
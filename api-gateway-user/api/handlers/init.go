@@ -2,16 +2,21 @@ package handlers
 
 import (
 	pb "gateway-admin/genprotos"
+	"gateway-admin/storage/managers"
 
 	"google.golang.org/grpc"
 )
 
 type HTTPHandler struct {
 	ProductManager pb.ProductServiceClient
+	OrderManager   pb.OrderServiceClient
+	CartManager    *managers.CartManager
 }
 
-func NewHandler(connP *grpc.ClientConn) *HTTPHandler {
+func NewHandler(connP, connO *grpc.ClientConn, cart *managers.CartManager) *HTTPHandler {
 	return &HTTPHandler{
 		ProductManager: pb.NewProductServiceClient(connP),
+		OrderManager:   pb.NewOrderServiceClient(connO),
+		CartManager:    cart,
 	}
 }
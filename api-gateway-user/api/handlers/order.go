@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+	"gateway-auth/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOrder godoc
+// @Summary Get an order
+// @Description Gets an order of the authenticated user.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} pb.OrderGRes "Order data"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-order/{id} [get]
+func (h *HTTPHandler) GetOrder(c *gin.Context) {
+	res, err := h.OrderManager.GetOrder(context.Background(), &pb.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get order", "details": status.Convert(err).Message()})
+		return
+	}
+	if res.UserId != userID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "failed to get order", "details": "order not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetOrders godoc
+// @Summary Get orders
+// @Description Gets orders of the authenticated user, newest first.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param status query string false "Order status"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} pb.OrderGARes "Orders"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-orders [get]
+func (h *HTTPHandler) GetOrders(c *gin.Context) {
+	req := pb.OrderGAReq{
+		UserId: userID(c),
+		Status: c.Query("status"),
+		Pagination: &pb.Pagination{
+			Limit:  cast.ToInt64(c.DefaultQuery("limit", "10")),
+			Offset: cast.ToInt64(c.DefaultQuery("offset", "0")),
+		},
+	}
+
+	res, err := h.OrderManager.ListOrders(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get orders", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CancelOrder godoc
+// @Summary Cancel an order
+// @Description Cancels an order of the authenticated user while it is still pending or confirmed.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param data body pb.OrderCancelReq false "Cancellation reason"
+// @Success 200 {object} string "Order is cancelled"
+// @Failure 404 {object} string "Order not found"
+// @Failure 409 {object} string "Order can't be cancelled"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /cancel-order/{id} [put]
+func (h *HTTPHandler) CancelOrder(c *gin.Context) {
+	var req pb.OrderCancelReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload", "details": err.Error()})
+			return
+		}
+	}
+	req.Id = c.Param("id")
+	req.UserId, req.UserRole = middleware.Actor(c)
+
+	_, err := h.OrderManager.CancelOrder(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to cancel order", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, "order is cancelled")
+}
+
+// GetOrderHistory godoc
+// @Summary Get order status history
+// @Description Gets the status timeline of an order of the authenticated user.
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} pb.OrderHistoryRes "Order status history"
+// @Failure 404 {object} string "Order not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /get-order-history/{id} [get]
+func (h *HTTPHandler) GetOrderHistory(c *gin.Context) {
+	order, err := h.OrderManager.GetOrder(context.Background(), &pb.ByID{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get order history", "details": status.Convert(err).Message()})
+		return
+	}
+	if order.UserId != userID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "failed to get order history", "details": "order not found"})
+		return
+	}
+
+	res, err := h.OrderManager.GetHistory(context.Background(), &pb.ByID{Id: order.Id})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to get order history", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func userID(c *gin.Context) string {
+	claims, exists := c.Get("claims")
+	if !exists {
+		return ""
+	}
+	id, _ := claims.(jwt.MapClaims)["user_id"].(string)
+	return id
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
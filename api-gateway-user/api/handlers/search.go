@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	pb "gateway-admin/genprotos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/status"
+)
+
+// Search godoc
+// @Summary Search products
+// @Description Full-text search over product name, category, description and details. Queries may be typed in Uzbek Latin or Cyrillic script. Results are ranked by relevance and come with highlighted snippets and counts by category and seller.
+// @Tags product
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query"
+// @Param category query string false "Category"
+// @Param seller query string false "Seller"
+// @Param limit query int false "Page size, at most 100"
+// @Param offset query int false "Offset"
+// @Success 200 {object} pb.SearchRes "Search results"
+// @Failure 400 {object} string "Empty query"
+// @Failure 500 {object} string "Server error"
+// @Router /search [get]
+func (h *HTTPHandler) Search(c *gin.Context) {
+	req := pb.SearchReq{
+		Query:    c.Query("q"),
+		Category: c.Query("category"),
+		Seller:   c.Query("seller"),
+		Pagination: &pb.Pagination{
+			Limit:  cast.ToInt64(c.DefaultQuery("limit", "10")),
+			Offset: cast.ToInt64(c.DefaultQuery("offset", "0")),
+		},
+	}
+
+	res, err := h.ProductManager.Search(context.Background(), &req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": "failed to search products", "details": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
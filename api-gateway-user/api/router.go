@@ -9,7 +9,7 @@ import (
 
 	_ "gateway-admin/api/docs"
 	"gateway-admin/api/handlers"
-	"gateway-admin/api/middleware"
+	"gateway-auth/middleware"
 )
 
 // @title Swaggers of Product manager
@@ -17,14 +17,31 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-func NewRouter(h *handlers.HTTPHandler) *gin.Engine {
+func NewRouter(h *handlers.HTTPHandler, auth *middleware.AuthClient) *gin.Engine {
 	router := gin.Default()
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	protected := router.Group("/", middleware.JWTMiddleware())
-	protected.Use(middleware.IsProductManagerMiddleware())
+	router.GET("/search", h.Search)
+	router.GET("/get-products", h.GetAllProducts)
+
+	protected := router.Group("/", middleware.JWTMiddleware(auth))
+	protected.Use(middleware.RequirePermission("product:write"))
 
 	protected.POST("/add-product", h.AddProduct)
 
+	user := router.Group("/", middleware.JWTMiddleware(auth))
+
+	user.GET("/get-order/:id", h.GetOrder)
+	user.GET("/get-orders", h.GetOrders)
+	user.PUT("/cancel-order/:id", h.CancelOrder)
+	user.GET("/get-order-history/:id", h.GetOrderHistory)
+
+	user.POST("/add-to-cart", h.AddToCart)
+	user.PUT("/update-cart-item/:product_id", h.UpdateCartItem)
+	user.DELETE("/remove-from-cart/:product_id", h.RemoveFromCart)
+	user.DELETE("/clear-cart", h.ClearCart)
+	user.GET("/get-cart", h.GetCart)
+	user.POST("/checkout", h.Checkout)
+
 	return router
 }
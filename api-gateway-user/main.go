@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"gateway-admin/api"
 	"gateway-admin/api/handlers"
 	"gateway-admin/config"
+	"gateway-admin/storage"
+	"gateway-admin/storage/managers"
+	"gateway-auth/middleware"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -18,10 +22,23 @@ func main() {
 	em.CheckErr(err)
 	defer ProductConn.Close()
 
-	handler := handlers.NewHandler(ProductConn)
+	AuthConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.AUTH_GRPC_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer AuthConn.Close()
+
+	OrderConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.ORDER_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer OrderConn.Close()
+
+	mongo, err := storage.ConnectMongo(&cf)
+	em.CheckErr(err)
+	defer mongo.Disconnect(context.Background())
+
+	cart := managers.NewCartManager(mongo, cf.MONGO_DB_NAME, cf.MONGO_COLLECTION_NAME)
+	handler := handlers.NewHandler(ProductConn, OrderConn, cart)
 
-	roter := api.NewRouter(handler)
+	roter := api.NewRouter(handler, middleware.NewAuthClient(AuthConn, cf.AUTH_CACHE_TTL))
 	if err := roter.Run(cf.API_GATEWAY_PM_PORT); err != nil {
 		panic(err)
 	}
-} 
+}
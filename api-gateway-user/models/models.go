@@ -0,0 +1,38 @@
+package models
+
+import pb "gateway-admin/genprotos"
+
+type MongoUser struct {
+	ID   string `bson:"user_id"`
+	Cart []Cart `bson:"cart"`
+}
+
+type Cart struct {
+	ProductID string `bson:"product_id" json:"product_id"`
+	Quantity  int    `bson:"quantity" json:"quantity"`
+}
+
+type AddToCartReq struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+type UpdateCartItemReq struct {
+	Quantity int `json:"quantity"`
+}
+
+type CartItemRes struct {
+	ProductID string          `json:"product_id"`
+	Quantity  int             `json:"quantity"`
+	Available bool            `json:"available"` // false when the product was removed or has less stock than requested
+	Product   *pb.ProductGRes `json:"product,omitempty"`
+}
+
+type CartRes struct {
+	Items      []CartItemRes `json:"items"`
+	TotalItems int           `json:"total_items"`
+}
+
+type CheckoutReq struct {
+	Address string `json:"address"`
+}
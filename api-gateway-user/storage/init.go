@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"gateway-admin/config"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func ConnectMongo(cf *config.Config) (*mongo.Client, error) {
+	clientOptions := options.Client().ApplyURI(cf.MONGO_URI)
+	client, err := mongo.Connect(context.TODO(), clientOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Ping(context.TODO(), nil); err != nil {
+		panic("MongoDB not connected due to error: " + err.Error())
+	}
+	fmt.Println("Successfully connected to database mongodb!")
+
+	return client, nil
+}
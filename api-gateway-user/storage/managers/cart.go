@@ -0,0 +1,188 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"gateway-admin/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CartManager works on the cart array of the user document auth-service
+// creates on registration.
+type CartManager struct {
+	Collection *mongo.Collection
+}
+
+func NewCartManager(client *mongo.Client, dbName, collectionName string) *CartManager {
+	collection := client.Database(dbName).Collection(collectionName)
+	return &CartManager{Collection: collection}
+}
+
+func (m *CartManager) Get(userID string) ([]models.Cart, error) {
+	var user models.MongoUser
+	err := m.Collection.FindOne(context.Background(), bson.M{"user_id": userID}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return []models.Cart{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Cart == nil {
+		user.Cart = []models.Cart{}
+	}
+	return user.Cart, nil
+}
+
+// AddItem increases the quantity of a product already in the cart or appends a new line.
+func (m *CartManager) AddItem(userID, productID string, quantity int) error {
+	ctx := context.Background()
+
+	// Accounts created before carts existed may have no document yet.
+	_, err := m.Collection.UpdateOne(ctx,
+		bson.M{"user_id": userID},
+		bson.M{"$setOnInsert": bson.M{"cart": bson.A{}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return err
+	}
+
+	res, err := m.Collection.UpdateOne(ctx,
+		unlocked(bson.M{"user_id": userID, "cart.product_id": productID}),
+		bson.M{"$inc": bson.M{"cart.$.quantity": quantity}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	res, err = m.Collection.UpdateOne(ctx,
+		unlocked(bson.M{"user_id": userID, "cart.product_id": bson.M{"$ne": productID}}),
+		bson.M{"$push": bson.M{"cart": models.Cart{ProductID: productID, Quantity: quantity}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	return m.checkUnlocked(userID)
+}
+
+func (m *CartManager) SetQuantity(userID, productID string, quantity int) error {
+	res, err := m.Collection.UpdateOne(context.Background(),
+		unlocked(bson.M{"user_id": userID, "cart.product_id": productID}),
+		bson.M{"$set": bson.M{"cart.$.quantity": quantity}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if err := m.checkUnlocked(userID); err != nil {
+			return err
+		}
+		return ErrNotInCart
+	}
+	return nil
+}
+
+func (m *CartManager) RemoveItem(userID, productID string) error {
+	res, err := m.Collection.UpdateOne(context.Background(),
+		unlocked(bson.M{"user_id": userID}),
+		bson.M{"$pull": bson.M{"cart": bson.M{"product_id": productID}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if err := m.checkUnlocked(userID); err != nil {
+			return err
+		}
+	}
+	if res.ModifiedCount == 0 {
+		return ErrNotInCart
+	}
+	return nil
+}
+
+func (m *CartManager) Clear(userID string) error {
+	res, err := m.Collection.UpdateOne(context.Background(),
+		unlocked(bson.M{"user_id": userID}),
+		bson.M{"$set": bson.M{"cart": bson.A{}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return m.checkUnlocked(userID)
+	}
+	return nil
+}
+
+// checkoutLockTTL bounds how long a crashed checkout can keep a cart locked.
+const checkoutLockTTL = time.Minute
+
+var (
+	ErrCheckoutInProgress = errors.New("checkout of this cart is already in progress")
+	ErrNotInCart          = errors.New("product is not in the cart")
+)
+
+// unlocked adds to a filter that no checkout holds the cart, so cart writes
+// can't change what a checkout in progress has reserved.
+func unlocked(filter bson.M) bson.M {
+	filter["$or"] = bson.A{
+		bson.M{"checkout_at": bson.M{"$exists": false}},
+		bson.M{"checkout_at": bson.M{"$lt": time.Now().Add(-checkoutLockTTL)}},
+	}
+	return filter
+}
+
+// checkUnlocked tells apart a write that matched nothing because a checkout
+// holds the cart.
+func (m *CartManager) checkUnlocked(userID string) error {
+	count, err := m.Collection.CountDocuments(context.Background(),
+		bson.M{"user_id": userID, "checkout_at": bson.M{"$gte": time.Now().Add(-checkoutLockTTL)}})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCheckoutInProgress
+	}
+	return nil
+}
+
+// Lock marks the cart as being checked out so that a second concurrent checkout
+// of the same cart is rejected instead of reserving stock twice.
+func (m *CartManager) Lock(userID string) error {
+	res, err := m.Collection.UpdateOne(context.Background(),
+		unlocked(bson.M{"user_id": userID}),
+		bson.M{"$set": bson.M{"checkout_at": time.Now()}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		count, err := m.Collection.CountDocuments(context.Background(), bson.M{"user_id": userID})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrCheckoutInProgress
+		}
+	}
+	return nil
+}
+
+func (m *CartManager) Unlock(userID string) error {
+	_, err := m.Collection.UpdateOne(context.Background(),
+		bson.M{"user_id": userID},
+		bson.M{"$unset": bson.M{"checkout_at": ""}})
+	return err
+}
+
+// ClearAndUnlock empties the cart and releases the checkout lock in one update.
+func (m *CartManager) ClearAndUnlock(userID string) error {
+	_, err := m.Collection.UpdateOne(context.Background(),
+		bson.M{"user_id": userID},
+		bson.M{"$set": bson.M{"cart": bson.A{}}, "$unset": bson.M{"checkout_at": ""}})
+	return err
+}
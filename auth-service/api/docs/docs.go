@@ -15,9 +15,34 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/confirm-registration": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Public keys that access and refresh tokens are signed with, identified by \"kid\". Keys that were rotated out stay listed until the tokens they signed have expired.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "JSON Web Key Set",
+                "responses": {
+                    "200": {
+                        "description": "Public keys",
+                        "schema": {
+                            "$ref": "#/definitions/token.JWKS"
+                        }
+                    }
+                }
+            }
+        },
+        "/2fa/confirm": {
             "post": {
-                "description": "Confirms a user's registration using the code sent to their email.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirms the secret from /2fa/enroll with a code from the authenticator app and enables 2FA. The recovery codes are shown only this once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,25 +50,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "registration"
+                    "two-factor"
                 ],
-                "summary": "Confirm registration with code",
+                "summary": "Finish 2FA setup",
                 "parameters": [
                     {
-                        "description": "Confirmation request",
-                        "name": "confirmation",
+                        "description": "Code",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ConfirmRegistrationReq"
+                            "$ref": "#/definitions/models.TwoFactorCodeReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT tokens",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/token.Tokens"
+                            "$ref": "#/definitions/models.RecoveryCodesResp"
                         }
                     },
                     "400": {
@@ -53,13 +78,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Incorrect verification code",
+                        "description": "Incorrect code",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Verification code expired or email not found",
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "string"
                         }
@@ -67,14 +98,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/forgot-password": {
+        "/2fa/disable": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sends a confirmation code to email recovery password",
+                "description": "Turns 2FA off and deletes the recovery codes. Requires a current code from the authenticator app. Admins and managers can't turn it off.",
                 "consumes": [
                     "application/json"
                 ],
@@ -82,26 +113,81 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "password-recovery"
+                    "two-factor"
                 ],
-                "summary": "Forgot passwrod",
+                "summary": "Disable 2FA",
                 "parameters": [
                     {
-                        "description": "User login credentials",
-                        "name": "credentials",
+                        "description": "Code",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ForgotPasswordReq"
+                            "$ref": "#/definitions/models.TwoFactorCodeReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Two-factor authentication disabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or 2FA is not enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "2FA is mandatory for this role",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "string"
                         }
+                    }
+                }
+            }
+        },
+        "/2fa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a new TOTP secret and its otpauth URI for a QR code. 2FA is enabled only after /2fa/confirm.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "two-factor"
+                ],
+                "summary": "Start 2FA setup",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorEnrollResp"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -109,8 +195,8 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Page not found",
+                    "409": {
+                        "description": "Two-factor authentication is already enabled",
                         "schema": {
                             "type": "string"
                         }
@@ -124,9 +210,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
+        "/2fa/recovery-codes": {
             "post": {
-                "description": "Authenticate user with email and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces all recovery codes, used or not, with new ones. Requires a current code from the authenticator app.",
                 "consumes": [
                     "application/json"
                 ],
@@ -134,35 +225,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "two-factor"
                 ],
-                "summary": "Login a user",
+                "summary": "New recovery codes",
                 "parameters": [
                     {
-                        "description": "User login credentials",
-                        "name": "credentials",
+                        "description": "Code",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.LoginReq"
+                            "$ref": "#/definitions/models.TwoFactorCodeReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT tokens",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/token.Tokens"
+                            "$ref": "#/definitions/models.RecoveryCodesResp"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or 2FA is not enabled",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "401": {
-                        "description": "Invalid email or password",
+                        "description": "Incorrect code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "string"
                         }
@@ -170,14 +273,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/profile": {
-            "get": {
+        "/account": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the profile of the authenticated user",
+                "description": "Deletes the authenticated user's account and cart and logs out every session. The account can be restored through /restore-account until the grace period ends.",
                 "consumes": [
                     "application/json"
                 ],
@@ -185,24 +288,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "account"
+                ],
+                "summary": "Delete account",
+                "parameters": [
+                    {
+                        "description": "Current password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteAccountReq"
+                        }
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Account deleted",
                         "schema": {
-                            "$ref": "#/definitions/models.GetProfileResp"
+                            "type": "string"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Incorrect password",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "429": {
+                        "description": "Too many attempts",
                         "schema": {
                             "type": "string"
                         }
@@ -216,9 +330,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/recover-password": {
+        "/change-email": {
             "post": {
-                "description": "Verifies the code and updates the password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a code to the new email. The email changes once the code is confirmed through /confirm-email-change.",
                 "consumes": [
                     "application/json"
                 ],
@@ -226,47 +345,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "password-recovery"
+                    "account"
                 ],
-                "summary": "Recover password (Use this one after sending verification code)",
+                "summary": "Change email",
                 "parameters": [
                     {
-                        "description": "Recover Password Request",
+                        "description": "New email and current password",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.RecoverPasswordReq"
+                            "$ref": "#/definitions/models.ChangeEmailReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Password successfully updated",
+                        "description": "Code sent to the new email",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid email or email already registered",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "401": {
-                        "description": "Incorrect verification code",
+                        "description": "Incorrect password",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Verification code expired or email not found",
+                    "429": {
+                        "description": "Too many attempts",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Error updating password",
+                        "description": "Server error",
                         "schema": {
                             "type": "string"
                         }
@@ -274,9 +393,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
+        "/change-password": {
             "post": {
-                "description": "Register a new user with email, username, and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the authenticated user. Every other session is logged out.",
                 "consumes": [
                     "application/json"
                 ],
@@ -284,29 +408,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "registration"
+                    "account"
                 ],
-                "summary": "Register a new user",
+                "summary": "Change password",
                 "parameters": [
                     {
-                        "description": "User registration request",
-                        "name": "user",
+                        "description": "Current and new password",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterReqSwag"
+                            "$ref": "#/definitions/models.ChangePasswordReq"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "JWT tokens",
+                    "200": {
+                        "description": "Password changed",
                         "schema": {
-                            "$ref": "#/definitions/token.Tokens"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or new password",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect current password",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
                         "schema": {
                             "type": "string"
                         }
@@ -319,26 +455,1098 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.ConfirmRegistrationReq": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
+        },
+        "/confirm-email-change": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirms the new email with the code sent to it. Every session is logged out and new tokens carrying the new email are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Confirm email change",
+                "parameters": [
+                    {
+                        "description": "New email and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfirmEmailChangeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Email already registered",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect verification code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Verification code expired or email not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 }
             }
         },
-        "models.ForgotPasswordReq": {
+        "/confirm-registration": {
+            "post": {
+                "description": "Confirms a user's registration using the code sent to their email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registration"
+                ],
+                "summary": "Confirm registration with code",
+                "parameters": [
+                    {
+                        "description": "Confirmation request",
+                        "name": "confirmation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfirmRegistrationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect verification code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Verification code expired or email not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts exporting everything stored about the authenticated user: profile, orders, delivery addresses, reviews and account events. An email with a download link is sent once it's ready. If an export is already in progress, it is returned instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Request a data export",
+                "responses": {
+                    "202": {
+                        "description": "Export started",
+                        "schema": {
+                            "$ref": "#/definitions/managers.ExportJob"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many exports requested",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "Too many exports in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/export/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the status of one of the authenticated user's exports.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Get a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export",
+                        "schema": {
+                            "$ref": "#/definitions/managers.ExportJob"
+                        }
+                    },
+                    "404": {
+                        "description": "Export not found or expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/export/{id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Downloads a ready export as a single JSON document or as a ZIP archive with one JSON file per section.",
+                "produces": [
+                    "application/zip",
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Download a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "zip (default) or json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Export not found or expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Export isn't ready",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/forgot-password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a confirmation code to email recovery password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "password-recovery"
+                ],
+                "summary": "Forgot passwrod",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ForgotPasswordReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticate user with email and password. Accounts with 2FA get a challenge token for /login/2fa instead of tokens; users whose role requires 2FA but who haven't set it up get one for setting it up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "202": {
+                        "description": "Second factor required",
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginChallengeResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email or password",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa": {
+            "post": {
+                "description": "Finishes a login that /login answered with two_factor_required, using a code from the authenticator app or a recovery code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "two-factor"
+                ],
+                "summary": "Second login step",
+                "parameters": [
+                    {
+                        "description": "Challenge token and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorLoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid challenge token or code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa/confirm": {
+            "post": {
+                "description": "Confirms the secret from /login/2fa/enroll with a code from the authenticator app, enables 2FA and logs in. The recovery codes are shown only this once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "two-factor"
+                ],
+                "summary": "Finish mandatory 2FA setup",
+                "parameters": [
+                    {
+                        "description": "Challenge token and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorSetupConfirmReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorSetupResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid challenge token or code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa/enroll": {
+            "post": {
+                "description": "For users whose role requires 2FA after /login answered with two_factor_setup_required. Returns a new TOTP secret to add to an authenticator app.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "two-factor"
+                ],
+                "summary": "Start mandatory 2FA setup",
+                "parameters": [
+                    {
+                        "description": "Challenge token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorSetupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TwoFactorEnrollResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid challenge token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Two-factor authentication is already enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the current session, so its access and refresh tokens stop working.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Log out",
+                "responses": {
+                    "200": {
+                        "description": "Logged out",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes every session of the authenticated user, including the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Log out everywhere",
+                "responses": {
+                    "200": {
+                        "description": "Logged out from all sessions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the profile of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetProfileResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/recover-password": {
+            "post": {
+                "description": "Verifies the code, updates the password and logs out every session of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "password-recovery"
+                ],
+                "summary": "Recover password (Use this one after sending verification code)",
+                "parameters": [
+                    {
+                        "description": "Recover Password Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RecoverPasswordReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password successfully updated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect verification code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Verification code expired or email not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Error updating password",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Exchanges a refresh token for a new token pair. Every refresh token can be used once; presenting one that was already exchanged revokes the whole session.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RefreshReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid, revoked or reused refresh token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Banned, or 2FA required but not set up",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Register a new user with email, username, and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "registration"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterReqSwag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/token.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/restore-account": {
+            "post": {
+                "description": "Undoes an account deletion while its grace period lasts. Log in afterwards as usual.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Restore a deleted account",
+                "parameters": [
+                    {
+                        "description": "Email and password of the deleted account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RestoreAccountReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Account restored",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect email or password",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Nothing to restore",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a user's profile. Email, confirmation status and locale are only shown to the user themselves and to roles with the user:read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Get a user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetProfileByIdResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "managers.ExportJob": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ChangeEmailReq": {
+            "type": "object",
+            "properties": {
+                "new_email": {
+                    "type": "string"
+                },
+                "password": {
+                    "description": "Current password",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ChangePasswordReq": {
+            "type": "object",
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfirmEmailChangeReq": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code sent to the new email",
+                    "type": "string"
+                },
+                "new_email": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfirmRegistrationReq": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DeleteAccountReq": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "Current password",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ForgotPasswordReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "User's email address",
+                    "type": "string"
+                }
+            }
+        },
+        "models.GetProfileByIdResp": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "email": {
                     "description": "User's email address",
                     "type": "string"
+                },
+                "id": {
+                    "description": "User's unique identifier",
+                    "type": "string"
+                },
+                "is_confirmed": {
+                    "type": "boolean"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
                 }
             }
         },
@@ -357,6 +1565,9 @@ const docTemplate = `{
                     "description": "Add IsConfirmed to the model",
                     "type": "boolean"
                 },
+                "locale": {
+                    "type": "string"
+                },
                 "password": {
                     "description": "User's password",
                     "type": "string"
@@ -366,6 +1577,22 @@ const docTemplate = `{
                 }
             }
         },
+        "models.LoginChallengeResp": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "two_factor_required": {
+                    "description": "Send a code to /login/2fa",
+                    "type": "boolean"
+                },
+                "two_factor_setup_required": {
+                    "description": "Set 2FA up through /login/2fa/enroll and /login/2fa/confirm",
+                    "type": "boolean"
+                }
+            }
+        },
         "models.LoginReq": {
             "type": "object",
             "properties": {
@@ -393,6 +1620,26 @@ const docTemplate = `{
                 }
             }
         },
+        "models.RecoveryCodesResp": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "description": "Shown only once, each works once",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.RefreshReq": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
         "models.RegisterReqSwag": {
             "type": "object",
             "properties": {
@@ -400,12 +1647,136 @@ const docTemplate = `{
                     "description": "User's email address",
                     "type": "string"
                 },
+                "locale": {
+                    "description": "Language of emails: uz, ru or en; Accept-Language if empty",
+                    "type": "string"
+                },
                 "password": {
                     "description": "User's password",
                     "type": "string"
                 }
             }
         },
+        "models.RestoreAccountReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorCodeReq": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code from the authenticator app",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorEnrollResp": {
+            "type": "object",
+            "properties": {
+                "otpauth_uri": {
+                    "description": "Show as a QR code",
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorLoginReq": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code from the authenticator app",
+                    "type": "string"
+                },
+                "recovery_code": {
+                    "description": "Or one of the recovery codes",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorSetupConfirmReq": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code from the authenticator app",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorSetupReq": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TwoFactorSetupResp": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "recovery_codes": {
+                    "description": "Shown only once, each works once",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "token.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                }
+            }
+        },
+        "token.JWKS": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/token.JWK"
+                    }
+                }
+            }
+        },
         "token.Tokens": {
             "type": "object",
             "properties": {
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"auth-service/config"
+	"auth-service/models"
+	"auth-service/storage/managers"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Changes the password of the authenticated user. Every other session is logged out.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param request body models.ChangePasswordReq true "Current and new password"
+// @Success 200 {object} string "Password changed"
+// @Failure 400 {object} string "Invalid request payload or new password"
+// @Failure 401 {object} string "Incorrect current password"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /change-password [post]
+func (h *HTTPHandler) ChangePassword(c *gin.Context) {
+	var req models.ChangePasswordReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if err := config.IsValidPassword(req.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, ok := h.checkPassword(c, "change-password", req.CurrentPassword)
+	if !ok {
+		return
+	}
+
+	err := h.US.UM.UpdatePassword(&models.UpdatePasswordReq{Email: user.Email, NewPassword: req.NewPassword})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating password", "details": err.Error()})
+		return
+	}
+	sessionID, _ := c.MustGet("claims").(jwt.MapClaims)["sid"].(string)
+	if err := h.Sessions.RevokeAll(user.ID, sessionID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Password changed, but other sessions couldn't be logged out", "details": err.Error()})
+		return
+	}
+	h.audit(c, user.ID, managers.AuditPasswordChanged, nil)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Password changed, other sessions were logged out"})
+}
+
+// ChangeEmail godoc
+// @Summary Change email
+// @Description Sends a code to the new email. The email changes once the code is confirmed through /confirm-email-change.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param request body models.ChangeEmailReq true "New email and current password"
+// @Success 200 {object} string "Code sent to the new email"
+// @Failure 400 {object} string "Invalid email or email already registered"
+// @Failure 401 {object} string "Incorrect password"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /change-email [post]
+func (h *HTTPHandler) ChangeEmail(c *gin.Context) {
+	var req models.ChangeEmailReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if !config.IsValidEmail(req.NewEmail) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email format"})
+		return
+	}
+	if !h.throttle(c, "change-email", req.NewEmail) {
+		return
+	}
+
+	user, ok := h.checkPassword(c, "change-email", req.Password)
+	if !ok {
+		return
+	}
+	if h.US.IsEmailExists(req.NewEmail) != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Email already registered"})
+		return
+	}
+
+	// The code is bound to the user, so only the account that asked for the
+	// change can confirm it.
+	if err := h.SendCode(managers.PurposeEmailChange, req.NewEmail, user.Locale, user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error sending confirmation code", "err": err.Error()})
+		return
+	}
+	h.audit(c, user.ID, managers.AuditEmailChangeRequested, map[string]string{"new_email": req.NewEmail})
+
+	c.JSON(http.StatusOK, gin.H{"message": "Confirmation code sent to " + req.NewEmail + ". Please use it within " + validFor(h.Codes.TTL(managers.PurposeEmailChange)) + "."})
+}
+
+// ConfirmEmailChange godoc
+// @Summary Confirm email change
+// @Description Confirms the new email with the code sent to it. Every session is logged out and new tokens carrying the new email are returned.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param request body models.ConfirmEmailChangeReq true "New email and code"
+// @Success 200 {object} token.Tokens "JWT tokens"
+// @Failure 400 {object} string "Email already registered"
+// @Failure 401 {object} string "Incorrect verification code"
+// @Failure 404 {object} string "Verification code expired or email not found"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /confirm-email-change [post]
+func (h *HTTPHandler) ConfirmEmailChange(c *gin.Context) {
+	var req models.ConfirmEmailChangeReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	oldEmail, _ := claims["email"].(string)
+
+	if !h.throttle(c, "confirm-email-change", req.NewEmail) {
+		return
+	}
+	requestedBy, ok := h.redeemCode(c, managers.PurposeEmailChange, req.NewEmail, req.Code)
+	if !ok {
+		return
+	}
+	if requestedBy != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Verification code expired or email not found"})
+		return
+	}
+
+	if h.US.IsEmailExists(req.NewEmail) != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Email already registered"})
+		return
+	}
+	if err := h.US.UM.UpdateEmail(userID, req.NewEmail); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating email", "details": err.Error()})
+		return
+	}
+
+	// Tokens carry the email, so every session is replaced.
+	if err := h.Sessions.RevokeAll(userID, ""); err != nil {
+		log.Printf("failed to revoke sessions of %s after email change: %v", userID, err)
+	}
+	h.audit(c, userID, managers.AuditEmailChanged, map[string]string{"old_email": oldEmail, "new_email": req.NewEmail})
+
+	tokens, err := h.issueTokens(userID, req.NewEmail)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error starting session", "details": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// DeleteAccount godoc
+// @Summary Delete account
+// @Description Deletes the authenticated user's account and cart and logs out every session. The account can be restored through /restore-account until the grace period ends.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param request body models.DeleteAccountReq true "Current password"
+// @Success 200 {object} string "Account deleted"
+// @Failure 401 {object} string "Incorrect password"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /account [delete]
+func (h *HTTPHandler) DeleteAccount(c *gin.Context) {
+	var req models.DeleteAccountReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	user, ok := h.checkPassword(c, "delete-account", req.Password)
+	if !ok {
+		return
+	}
+
+	restoreUntil := time.Now().Add(h.DeleteGrace)
+	if err := h.US.UM.SoftDelete(user.ID, user.Email, restoreUntil); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error deleting account", "details": err.Error()})
+		return
+	}
+	if err := h.Sessions.RevokeAll(user.ID, ""); err != nil {
+		log.Printf("failed to revoke sessions of deleted user %s: %v", user.ID, err)
+	}
+	h.audit(c, user.ID, managers.AuditAccountDeleted, map[string]string{"restore_until": restoreUntil.Format(time.RFC3339)})
+
+	c.JSON(http.StatusOK, gin.H{"message": "Account deleted. You can restore it until " + restoreUntil.Format(time.RFC3339) + "."})
+}
+
+// RestoreAccount godoc
+// @Summary Restore a deleted account
+// @Description Undoes an account deletion while its grace period lasts. Log in afterwards as usual.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param request body models.RestoreAccountReq true "Email and password of the deleted account"
+// @Success 200 {object} string "Account restored"
+// @Failure 401 {object} string "Incorrect email or password"
+// @Failure 404 {object} string "Nothing to restore"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Router /restore-account [post]
+func (h *HTTPHandler) RestoreAccount(c *gin.Context) {
+	var req models.RestoreAccountReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	if !h.throttle(c, "restore-account", req.Email) {
+		return
+	}
+
+	account, err := h.US.UM.DeletedByEmail(req.Email)
+	if errors.Is(err, managers.ErrNothingToRestore) {
+		h.fail(c, req.Email, http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if !config.CheckPasswordHash(req.Password, account.Password) {
+		h.fail(c, req.Email, http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		return
+	}
+
+	if err := h.US.UM.Restore(account); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error restoring account", "details": err.Error()})
+		return
+	}
+	h.Limits.Succeed(limitKey(req.Email))
+	h.audit(c, account.UserID, managers.AuditAccountRestored, nil)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Account restored, you can log in again"})
+}
+
+// checkPassword verifies the authenticated user's current password. If it is
+// wrong, the request has already been answered.
+func (h *HTTPHandler) checkPassword(c *gin.Context, action, password string) (*models.GetProfileResp, bool) {
+	email, _ := c.MustGet("claims").(jwt.MapClaims)["email"].(string)
+	if !h.throttle(c, action, email) {
+		return nil, false
+	}
+	user, err := h.US.GetProfile(&models.GetProfileReq{Email: email})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error fetching user", "details": err.Error()})
+		return nil, false
+	}
+	if !config.CheckPasswordHash(password, user.Password) {
+		h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Incorrect password"})
+		return nil, false
+	}
+	h.Limits.Succeed(limitKey(email))
+	return user, true
+}
+
+// audit records an account event. The action already happened, so a failure
+// is only logged.
+func (h *HTTPHandler) audit(c *gin.Context, userID, action string, details map[string]string) {
+	if err := h.US.UM.Audit(userID, action, c.ClientIP(), details); err != nil {
+		log.Printf("failed to audit %s of %s: %v", action, userID, err)
+	}
+}
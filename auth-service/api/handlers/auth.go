@@ -3,13 +3,16 @@ package handlers
 import (
 	"auth-service/api/token"
 	"auth-service/config"
+	"auth-service/mailer"
 	"auth-service/models"
-	"context"
+	"auth-service/storage/managers"
+	"database/sql"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-redis/redis"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	_ "github.com/swaggo/swag"
 )
 
@@ -52,6 +55,7 @@ func (h *HTTPHandler) Register(c *gin.Context) {
 	}
 
 	req.Password = string(hashedPassword)
+	req.Locale = mailer.Locale(req.Locale, c.GetHeader("Accept-Language"))
 
 	err = h.US.Register(&req)
 	if err != nil {
@@ -59,13 +63,13 @@ func (h *HTTPHandler) Register(c *gin.Context) {
 		return
 	}
 
-	err = h.SendConfirmationCode(req.Email)
+	err = h.SendCode(managers.PurposeRegistration, req.Email, req.Locale, "")
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error sending confirmation code", "err": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Your account has been registered. Please check your email for a confirmation link. You have 3 minutes to confirm your account."})
+	c.JSON(http.StatusOK, gin.H{"message": "Your account has been registered. Please check your email for a confirmation link. You have " + validFor(h.Codes.TTL(managers.PurposeRegistration)) + " to confirm your account."})
 }
 
 // ConfirmRegistration godoc
@@ -79,6 +83,7 @@ func (h *HTTPHandler) Register(c *gin.Context) {
 // @Failure 400 {object} string "Invalid request payload"
 // @Failure 401 {object} string "Incorrect verification code"
 // @Failure 404 {object} string "Verification code expired or email not found"
+// @Failure 429 {object} string "Too many attempts"
 // @Router /confirm-registration [post]
 func (h *HTTPHandler) ConfirmRegistration(c *gin.Context) {
 	var req models.ConfirmRegistrationReq
@@ -87,21 +92,15 @@ func (h *HTTPHandler) ConfirmRegistration(c *gin.Context) {
 		return
 	}
 
-	storedCode, err := rdb.Get(context.Background(), req.Email).Result()
-	if err == redis.Nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Verification code expired or email not found"})
-		return
-	} else if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "This email not found in confirmation requests!"})
+	if !h.throttle(c, "confirm-registration", req.Email) {
 		return
 	}
 
-	if storedCode != req.Code {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Incorrect verification code"})
+	if _, ok := h.redeemCode(c, managers.PurposeRegistration, req.Email, req.Code); !ok {
 		return
 	}
 
-	err = h.US.UM.ConfirmUser(&models.ConfirmUserReq{Email: req.Email})
+	err := h.US.UM.ConfirmUser(&models.ConfirmUserReq{Email: req.Email})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error confirming user", "details": err.Error()})
 		return
@@ -113,23 +112,27 @@ func (h *HTTPHandler) ConfirmRegistration(c *gin.Context) {
 		return
 	}
 
-	tokens := token.GenerateJWTToken(user.ID, user.Email, user.Role)
-
-	rdb.Del(context.Background(), req.Email)
+	tokens, err := h.issueTokens(user.ID, user.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error starting session", "details": err.Error()})
+		return
+	}
 
 	c.JSON(http.StatusOK, tokens)
 }
 
 // Login godoc
 // @Summary Login a user
-// @Description Authenticate user with email and password
+// @Description Authenticate user with email and password. Accounts with 2FA get a challenge token for /login/2fa instead of tokens; users whose role requires 2FA but who haven't set it up get one for setting it up.
 // @Tags login
 // @Accept json
 // @Produce json
 // @Param credentials body models.LoginReq true "User login credentials"
 // @Success 200 {object} token.Tokens "JWT tokens"
+// @Success 202 {object} models.LoginChallengeResp "Second factor required"
 // @Failure 400 {object} string "Invalid request payload"
 // @Failure 401 {object} string "Invalid email or password"
+// @Failure 429 {object} string "Too many attempts"
 // @Router /login [post]
 func (h *HTTPHandler) Login(c *gin.Context) {
 	req := models.LoginReq{}
@@ -138,19 +141,24 @@ func (h *HTTPHandler) Login(c *gin.Context) {
 		return
 	}
 
+	if !h.throttle(c, "login", req.Email) {
+		return
+	}
+
 	user, err := h.US.GetProfile(&models.GetProfileReq{Email: req.Email})
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User registered with this email not found"})
+		h.fail(c, req.Email, http.StatusUnauthorized, gin.H{"error": "User registered with this email not found"})
 		return
 	}
 
 	if !config.CheckPasswordHash(req.Password, user.Password) {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		h.fail(c, req.Email, http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
 		return
 	}
+	h.Limits.Succeed(limitKey(req.Email))
 
 	if !user.IsConfirmed {
-		err = h.SendConfirmationCode(req.Email)
+		err = h.SendCode(managers.PurposeRegistration, req.Email, user.Locale, "")
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error sending confirmation code", "err": err.Error()})
 			return
@@ -161,11 +169,45 @@ func (h *HTTPHandler) Login(c *gin.Context) {
 	}
 
 	if user.Role == "banned" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You are banned"})
+		ban, err := h.US.UM.ActiveBan(user.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+			return
+		}
+		c.JSON(http.StatusForbidden, gin.H{"error": "You are banned", "ban": ban})
+		return
+	}
+
+	state, err := h.TwoFactor.State(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if state.Enabled {
+		c.JSON(http.StatusAccepted, models.LoginChallengeResp{
+			ChallengeToken:    token.GenerateChallenge(user.ID, user.Email, user.Role, token.ChallengeTwoFactor),
+			TwoFactorRequired: true,
+		})
+		return
+	}
+	required, err := h.US.UM.TwoFactorRequired(user.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if required {
+		c.JSON(http.StatusAccepted, models.LoginChallengeResp{
+			ChallengeToken:         token.GenerateChallenge(user.ID, user.Email, user.Role, token.ChallengeSetup),
+			TwoFactorSetupRequired: true,
+		})
 		return
 	}
 
-	tokens := token.GenerateJWTToken(user.ID, user.Email, user.Role)
+	tokens, err := h.issueTokens(user.ID, user.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error starting session", "details": err.Error()})
+		return
+	}
 
 	c.JSON(http.StatusOK, tokens)
 }
@@ -204,12 +246,79 @@ func (h *HTTPHandler) Profile(c *gin.Context) {
 	c.JSON(http.StatusOK, user)
 }
 
+// GetByID godoc
+// @Summary Get a user by ID
+// @Description Returns a user's profile. Email, confirmation status and locale are only shown to the user themselves and to roles with the user:read permission.
+// @Tags profile
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} models.GetProfileByIdResp "User profile"
+// @Failure 400 {object} string "Invalid user ID"
+// @Failure 404 {object} string "User not found"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /user/{id} [get]
 func (h *HTTPHandler) GetByID(c *gin.Context) {
 	id := &models.GetProfileByIdReq{ID: c.Param("id")}
+	if err := config.IsValidUUID(id.ID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	user, err := h.US.GetByID(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"Couldn't get the user": err.Error()})
 		return
 	}
+
+	userID, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	if userID != user.ID {
+		role, ok := h.currentRole(c)
+		if !ok {
+			return
+		}
+		canRead, err := h.US.UM.HasPermission(role, managers.PermissionUserRead)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+			return
+		}
+		if !canRead {
+			user = user.Public()
+		}
+	}
 	c.JSON(http.StatusOK, user)
 }
+
+// issueTokens starts a new session for the user and returns its first token
+// pair, carrying the role the user has now.
+func (h *HTTPHandler) issueTokens(userID, email string) (*token.Tokens, error) {
+	role, err := h.US.Role(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, refreshID := uuid.NewString(), uuid.NewString()
+	if err := h.Sessions.Create(sessionID, userID, refreshID, token.RefreshTTL); err != nil {
+		return nil, err
+	}
+	return token.GenerateJWTToken(userID, email, role, sessionID, refreshID), nil
+}
+
+// currentRole looks up the authenticated user's role as it is now, since the
+// token's role claim is as old as the token. If it can't, the request has
+// already been answered.
+func (h *HTTPHandler) currentRole(c *gin.Context) (string, bool) {
+	userID, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	role, err := h.US.Role(userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Account not found"})
+		return "", false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return "", false
+	}
+	return role, true
+}
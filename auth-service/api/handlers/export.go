@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"auth-service/service"
+	"auth-service/storage/managers"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// RequestExport godoc
+// @Summary Request a data export
+// @Description Starts exporting everything stored about the authenticated user: profile, orders, delivery addresses, reviews and account events. An email with a download link is sent once it's ready. If an export is already in progress, it is returned instead.
+// @Tags export
+// @Produce json
+// @Success 202 {object} managers.ExportJob "Export started"
+// @Failure 429 {object} string "Too many exports requested"
+// @Failure 500 {object} string "Server error"
+// @Failure 503 {object} string "Too many exports in progress"
+// @Security BearerAuth
+// @Router /export [post]
+func (h *HTTPHandler) RequestExport(c *gin.Context) {
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+	if !h.throttle(c, "export", email) {
+		return
+	}
+
+	job, err := h.Exports.Request(userID)
+	if errors.Is(err, service.ErrExportQueueFull) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	h.audit(c, userID, managers.AuditDataExportRequested, map[string]string{"export_id": job.ID})
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetExport godoc
+// @Summary Get a data export
+// @Description Returns the status of one of the authenticated user's exports.
+// @Tags export
+// @Produce json
+// @Param id path string true "Export ID"
+// @Success 200 {object} managers.ExportJob "Export"
+// @Failure 404 {object} string "Export not found or expired"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /export/{id} [get]
+func (h *HTTPHandler) GetExport(c *gin.Context) {
+	job, ok := h.ownExport(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// DownloadExport godoc
+// @Summary Download a data export
+// @Description Downloads a ready export as a single JSON document or as a ZIP archive with one JSON file per section.
+// @Tags export
+// @Produce application/zip,json
+// @Param id path string true "Export ID"
+// @Param format query string false "zip (default) or json"
+// @Success 200 {file} file "Export"
+// @Failure 400 {object} string "Unknown format"
+// @Failure 404 {object} string "Export not found or expired"
+// @Failure 409 {object} string "Export isn't ready"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /export/{id}/download [get]
+func (h *HTTPHandler) DownloadExport(c *gin.Context) {
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be zip or json"})
+		return
+	}
+	job, ok := h.ownExport(c)
+	if !ok {
+		return
+	}
+	if job.Status != managers.ExportReady {
+		c.JSON(http.StatusConflict, gin.H{"error": "Export is " + job.Status, "export": job})
+		return
+	}
+
+	c.FileAttachment(h.Exports.File(job, format), "data-export-"+job.ID+"."+format)
+}
+
+// ownExport loads the export in the path, answering 404 for other users'
+// exports so their IDs can't be probed.
+func (h *HTTPHandler) ownExport(c *gin.Context) (*managers.ExportJob, bool) {
+	userID, _ := c.MustGet("claims").(jwt.MapClaims)["user_id"].(string)
+	job, err := h.Exports.Get(c.Param("id"))
+	if errors.Is(err, managers.ErrExportNotFound) || (err == nil && job.UserID != userID) {
+		c.JSON(http.StatusNotFound, gin.H{"error": managers.ErrExportNotFound.Error()})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return nil, false
+	}
+	return job, true
+}
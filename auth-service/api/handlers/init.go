@@ -1,13 +1,25 @@
 package handlers
 
 import (
+	"auth-service/mailer"
 	"auth-service/service"
+	"auth-service/storage/managers"
+	"time"
 )
 
 type HTTPHandler struct {
-	US *service.UserService
+	US         *service.UserService
+	Codes      *managers.CodeManager
+	Mail       *mailer.Queue
+	Sessions   *managers.SessionManager
+	Limits     *managers.LimitManager
+	TwoFactor  *managers.TwoFactorManager
+	Exports    *service.ExportService
+	TOTPIssuer string
+	// DeleteGrace is how long a deleted account can be restored.
+	DeleteGrace time.Duration
 }
 
-func NewHandler(us *service.UserService) *HTTPHandler {
-	return &HTTPHandler{US: us}
+func NewHandler(us *service.UserService, sessions *managers.SessionManager, limits *managers.LimitManager, codes *managers.CodeManager, mail *mailer.Queue, twoFactor *managers.TwoFactorManager, exports *service.ExportService, totpIssuer string, deleteGrace time.Duration) *HTTPHandler {
+	return &HTTPHandler{US: us, Sessions: sessions, Limits: limits, Codes: codes, Mail: mail, TwoFactor: twoFactor, Exports: exports, TOTPIssuer: totpIssuer, DeleteGrace: deleteGrace}
 }
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"auth-service/storage/managers"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func limitKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// throttle counts the request towards the action's rate limits and checks the
+// account lockout. If the request may not go on, it has already been answered.
+func (h *HTTPHandler) throttle(c *gin.Context, action, email string) bool {
+	wait, err := h.Limits.Allow(action, c.ClientIP(), limitKey(email))
+	if err == nil && wait == 0 && email != "" {
+		wait, err = h.Limits.LockedFor(limitKey(email))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return false
+	}
+	if wait > 0 {
+		tooManyRequests(c, wait)
+		return false
+	}
+	return true
+}
+
+func tooManyRequests(c *gin.Context, wait time.Duration) {
+	seconds := int(math.Ceil(wait.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many attempts, try again later", "retry_after": seconds})
+}
+
+// fail records a failed attempt on the account and answers with status and
+// body, or with 429 if this failure locked the account.
+func (h *HTTPHandler) fail(c *gin.Context, email string, status int, body gin.H) {
+	lockout, err := h.Limits.Fail(limitKey(email))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if lockout > 0 {
+		tooManyRequests(c, lockout)
+		return
+	}
+	c.JSON(status, body)
+}
+
+// wrongCode is fail for an incorrect verification code, which also counts
+// towards invalidating the code.
+func (h *HTTPHandler) wrongCode(c *gin.Context, purpose managers.Purpose, email string) {
+	invalidated, err := h.Limits.WrongCode(purpose, limitKey(email))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if invalidated {
+		h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Too many incorrect codes, this code is no longer valid. Please request a new one."})
+		return
+	}
+	h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Incorrect verification code"})
+}
@@ -2,55 +2,77 @@ package handlers
 
 import (
 	"auth-service/config"
+	"auth-service/mailer"
 	"auth-service/models"
-	"context"
+	"auth-service/storage/managers"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/redis/go-redis/v9"
-	"gopkg.in/gomail.v2"
 )
 
-var rdb = redis.NewClient(&redis.Options{
-	Addr: "localhost:6379",
-	DB:   0,
-})
-
-func (h *HTTPHandler) SendConfirmationCode(email string) error {
+// SendCode issues a new code for the flow, replacing the flow's previous
+// code, and queues the email carrying it in the user's locale. data is kept
+// with the code, see CodeManager.Issue.
+func (h *HTTPHandler) SendCode(purpose managers.Purpose, email, locale, data string) error {
 	code, err := generateConfirmationCode()
 	if err != nil {
 		return err
 	}
 
-	m := gomail.NewMessage()
-	m.SetHeader("From", config.Load().SENDER_EMAIL)
-	m.SetHeader("To", email)
-	m.SetHeader("Subject", "Password Recovery Code")
-	m.SetBody("text/plain", fmt.Sprintf("Your password recovery code is: %d", code))
-
-	d := gomail.NewDialer("smtp.gmail.com", 587, config.Load().SENDER_EMAIL, config.Load().APP_PASSWORD)
-
-	if err := d.DialAndSend(m); err != nil {
+	msg, err := mailer.Render(string(purpose), locale, email, map[string]any{
+		"Code":    code,
+		"Minutes": int(h.Codes.TTL(purpose).Minutes()),
+	})
+	if err != nil {
 		return err
 	}
 
-	err = rdb.Set(context.Background(), email, code, 3*time.Minute).Err()
-	if err != nil {
+	if err := h.Codes.Issue(purpose, email, code, data); err != nil {
 		return fmt.Errorf("server error storing confirmation code in Redis")
 	}
-	return nil
+	if err := h.Limits.ResetCode(purpose, limitKey(email)); err != nil {
+		return err
+	}
+	return h.Mail.Enqueue(msg)
+}
+
+// redeemCode consumes the email's code for the flow. If the code can't be
+// used, the request has already been answered.
+func (h *HTTPHandler) redeemCode(c *gin.Context, purpose managers.Purpose, email, code string) (string, bool) {
+	data, err := h.Codes.Redeem(purpose, email, code)
+	switch {
+	case errors.Is(err, managers.ErrCodeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Verification code expired or email not found"})
+		return "", false
+	case errors.Is(err, managers.ErrCodeMismatch):
+		h.wrongCode(c, purpose, email)
+		return "", false
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return "", false
+	}
+	h.Limits.Succeed(limitKey(email))
+	return data, true
+}
+
+func validFor(ttl time.Duration) string {
+	if minutes := int(ttl.Minutes()); minutes > 0 {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+	return ttl.String()
 }
 
-func generateConfirmationCode() (int, error) {
+func generateConfirmationCode() (string, error) {
 	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
 	if err != nil {
-		return 0, err
+		return "", err
 	}
-	return int(n.Int64()), nil
+	return fmt.Sprintf("%06d", n.Int64()), nil
 }
 
 
@@ -64,6 +86,7 @@ func generateConfirmationCode() (int, error) {
 // @Success 200 {object} string ""
 // @Failure 401 {object} string "Unauthorized"
 // @Failure 404 {object} string "Page not found"
+// @Failure 429 {object} string "Too many attempts"
 // @Failure 500 {object} string "Server error"
 // @Security BearerAuth
 // @Router /forgot-password [POST]
@@ -79,6 +102,10 @@ func (h *HTTPHandler) ForgotPassword(c *gin.Context) {
 		return
 	}
 
+	if !h.throttle(c, "forgot-password", req.Email) {
+		return
+	}
+
 	user, err := h.US.GetProfile(&models.GetProfileReq{Email: req.Email})
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "details": err.Error()})
@@ -89,18 +116,18 @@ func (h *HTTPHandler) ForgotPassword(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 		return
 	}
-	err = h.SendConfirmationCode(user.Email)
+	err = h.SendCode(managers.PurposePasswordReset, user.Email, user.Locale, "")
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error sending confirmation code to email", "err": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Confirmation code sent to your email. Please use your code within 3 minutes."})
+	c.JSON(http.StatusOK, gin.H{"message": "Confirmation code sent to your email. Please use your code within " + validFor(h.Codes.TTL(managers.PurposePasswordReset)) + "."})
 }
 
 // RecoverPassword godoc
 // @Summary Recover password (Use this one after sending verification code)
-// @Description Verifies the code and updates the password
+// @Description Verifies the code, updates the password and logs out every session of the user
 // @Tags password-recovery
 // @Accept json
 // @Produce json
@@ -109,6 +136,7 @@ func (h *HTTPHandler) ForgotPassword(c *gin.Context) {
 // @Failure 400 {object} string "Invalid request payload"
 // @Failure 401 {object} string "Incorrect verification code"
 // @Failure 404 {object} string "Verification code expired or email not found"
+// @Failure 429 {object} string "Too many attempts"
 // @Failure 500 {object} string "Error updating password"
 // @Router /recover-password [post]
 func (h *HTTPHandler) RecoverPassword(c *gin.Context) {
@@ -128,26 +156,30 @@ func (h *HTTPHandler) RecoverPassword(c *gin.Context) {
 		return
 	}
 
-	storedCode, err := rdb.Get(context.Background(), req.Email).Result()
-	if err == redis.Nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Verification code expired or email not found"})
-		return
-	} else if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "This email not found in a recovery requests!"})
+	if !h.throttle(c, "recover-password", req.Email) {
 		return
 	}
 
-	if storedCode != req.Code {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Incorrect verification code"})
+	if _, ok := h.redeemCode(c, managers.PurposePasswordReset, req.Email, req.Code); !ok {
 		return
 	}
 
+	user, err := h.US.GetProfile(&models.GetProfileReq{Email: req.Email})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating password", "details": err.Error()})
+		return
+	}
 	err = h.US.UM.UpdatePassword(&models.UpdatePasswordReq{Email: req.Email, NewPassword: req.NewPassword})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating password", "details": err.Error()})
 		return
 	}
-	rdb.Del(context.Background(), req.Email)
+	// Whoever knew the old password mustn't stay logged in
+	if err := h.Sessions.RevokeAll(user.ID, ""); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Password updated, but existing sessions couldn't be logged out", "details": err.Error()})
+		return
+	}
+	h.audit(c, user.ID, managers.AuditPasswordReset, nil)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Password successfully updated"})
+	c.JSON(http.StatusOK, gin.H{"message": "Password successfully updated, all sessions were logged out"})
 }
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"auth-service/api/token"
+	"auth-service/models"
+	"auth-service/storage/managers"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// Refresh godoc
+// @Summary Refresh tokens
+// @Description Exchanges a refresh token for a new token pair. Every refresh token can be used once; presenting one that was already exchanged revokes the whole session.
+// @Tags session
+// @Accept json
+// @Produce json
+// @Param request body models.RefreshReq true "Refresh token"
+// @Success 200 {object} token.Tokens "JWT tokens"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 401 {object} string "Invalid, revoked or reused refresh token"
+// @Failure 403 {object} string "Banned, or 2FA required but not set up"
+// @Failure 500 {object} string "Server error"
+// @Router /refresh [post]
+func (h *HTTPHandler) Refresh(c *gin.Context) {
+	var req models.RefreshReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+
+	claims, err := token.ExtractTyped(req.RefreshToken, token.TypeRefresh)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token", "details": err.Error()})
+		return
+	}
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+	sessionID, _ := claims["sid"].(string)
+	refreshID, _ := claims["jti"].(string)
+
+	// The role may have changed since login, so it is read again.
+	user, err := h.US.GetProfile(&models.GetProfileReq{Email: email})
+	if err != nil || user.ID != userID {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
+	if user.Role == "banned" {
+		h.Sessions.RevokeAll(userID, "")
+		c.JSON(http.StatusForbidden, gin.H{"error": "You are banned"})
+		return
+	}
+	required, err := h.US.UM.TwoFactorRequired(user.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if required {
+		state, err := h.TwoFactor.State(userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+			return
+		}
+		if !state.Enabled {
+			h.Sessions.Revoke(sessionID, userID)
+			c.JSON(http.StatusForbidden, gin.H{"error": "Two-factor authentication is required for " + user.Role + "s, please log in again to set it up"})
+			return
+		}
+	}
+
+	newRefreshID := uuid.NewString()
+	err = h.Sessions.Rotate(sessionID, userID, refreshID, newRefreshID, token.RefreshTTL)
+	if errors.Is(err, managers.ErrSessionNotFound) || errors.Is(err, managers.ErrRefreshReused) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token", "details": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, token.GenerateJWTToken(user.ID, user.Email, user.Role, sessionID, newRefreshID))
+}
+
+// Logout godoc
+// @Summary Log out
+// @Description Revokes the current session, so its access and refresh tokens stop working.
+// @Tags session
+// @Accept json
+// @Produce json
+// @Success 200 {object} string "Logged out"
+// @Failure 401 {object} string "Unauthorized"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /logout [post]
+func (h *HTTPHandler) Logout(c *gin.Context) {
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	sessionID, _ := claims["sid"].(string)
+
+	if err := h.Sessions.Revoke(sessionID, userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out"})
+}
+
+// LogoutAll godoc
+// @Summary Log out everywhere
+// @Description Revokes every session of the authenticated user, including the current one.
+// @Tags session
+// @Accept json
+// @Produce json
+// @Success 200 {object} string "Logged out from all sessions"
+// @Failure 401 {object} string "Unauthorized"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /logout-all [post]
+func (h *HTTPHandler) LogoutAll(c *gin.Context) {
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+
+	if err := h.Sessions.RevokeAll(userID, ""); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out from all sessions"})
+}
+
+// JWKS godoc
+// @Summary JSON Web Key Set
+// @Description Public keys that access and refresh tokens are signed with, identified by "kid". Keys that were rotated out stay listed until the tokens they signed have expired.
+// @Tags session
+// @Produce json
+// @Success 200 {object} token.JWKS "Public keys"
+// @Router /.well-known/jwks.json [get]
+func (h *HTTPHandler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, token.PublicKeys())
+}
@@ -0,0 +1,430 @@
+package handlers
+
+import (
+	"auth-service/api/token"
+	"auth-service/models"
+	"auth-service/storage/managers"
+	"auth-service/totp"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	recoveryCodeCount    = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// LoginTwoFactor godoc
+// @Summary Second login step
+// @Description Finishes a login that /login answered with two_factor_required, using a code from the authenticator app or a recovery code.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorLoginReq true "Challenge token and code"
+// @Success 200 {object} token.Tokens "JWT tokens"
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 401 {object} string "Invalid challenge token or code"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Router /login/2fa [post]
+func (h *HTTPHandler) LoginTwoFactor(c *gin.Context) {
+	var req models.TwoFactorLoginReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+
+	claims, err := token.ExtractChallenge(req.ChallengeToken, token.ChallengeTwoFactor)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid challenge token", "details": err.Error()})
+		return
+	}
+	userID, email := challengeUser(claims)
+	if !h.throttle(c, "login-2fa", email) {
+		return
+	}
+
+	state, err := h.TwoFactor.State(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if !state.Enabled {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": managers.ErrTwoFactorNotEnabled.Error()})
+		return
+	}
+
+	var ok bool
+	if req.RecoveryCode != "" {
+		ok, err = h.TwoFactor.UseRecoveryCode(userID, req.RecoveryCode)
+	} else {
+		ok, err = h.checkTOTP(userID, state.Secret, req.Code)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if !ok {
+		h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Incorrect two-factor code"})
+		return
+	}
+	if !h.spendChallenge(c, claims) {
+		return
+	}
+	h.Limits.Succeed(limitKey(email))
+
+	tokens, err := h.issueTokens(userID, email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error starting session", "details": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// EnrollTwoFactorAtLogin godoc
+// @Summary Start mandatory 2FA setup
+// @Description For users whose role requires 2FA after /login answered with two_factor_setup_required. Returns a new TOTP secret to add to an authenticator app.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorSetupReq true "Challenge token"
+// @Success 200 {object} models.TwoFactorEnrollResp
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 401 {object} string "Invalid challenge token"
+// @Failure 409 {object} string "Two-factor authentication is already enabled"
+// @Failure 500 {object} string "Server error"
+// @Router /login/2fa/enroll [post]
+func (h *HTTPHandler) EnrollTwoFactorAtLogin(c *gin.Context) {
+	var req models.TwoFactorSetupReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	claims, err := token.ExtractChallenge(req.ChallengeToken, token.ChallengeSetup)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid challenge token", "details": err.Error()})
+		return
+	}
+	userID, email := challengeUser(claims)
+	h.enroll(c, userID, email)
+}
+
+// ConfirmTwoFactorAtLogin godoc
+// @Summary Finish mandatory 2FA setup
+// @Description Confirms the secret from /login/2fa/enroll with a code from the authenticator app, enables 2FA and logs in. The recovery codes are shown only this once.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorSetupConfirmReq true "Challenge token and code"
+// @Success 200 {object} models.TwoFactorSetupResp
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 401 {object} string "Invalid challenge token or code"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Router /login/2fa/confirm [post]
+func (h *HTTPHandler) ConfirmTwoFactorAtLogin(c *gin.Context) {
+	var req models.TwoFactorSetupConfirmReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	claims, err := token.ExtractChallenge(req.ChallengeToken, token.ChallengeSetup)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid challenge token", "details": err.Error()})
+		return
+	}
+	userID, email := challengeUser(claims)
+
+	recoveryCodes, ok := h.confirm(c, userID, email, req.Code)
+	if !ok || !h.spendChallenge(c, claims) {
+		return
+	}
+
+	tokens, err := h.issueTokens(userID, email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error starting session", "details": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.TwoFactorSetupResp{
+		AccessToken:   tokens.AccessToken,
+		RefreshToken:  tokens.RefreshToken,
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+// EnrollTwoFactor godoc
+// @Summary Start 2FA setup
+// @Description Returns a new TOTP secret and its otpauth URI for a QR code. 2FA is enabled only after /2fa/confirm.
+// @Tags two-factor
+// @Produce json
+// @Success 200 {object} models.TwoFactorEnrollResp
+// @Failure 401 {object} string "Unauthorized"
+// @Failure 409 {object} string "Two-factor authentication is already enabled"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /2fa/enroll [post]
+func (h *HTTPHandler) EnrollTwoFactor(c *gin.Context) {
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+	h.enroll(c, userID, email)
+}
+
+// ConfirmTwoFactor godoc
+// @Summary Finish 2FA setup
+// @Description Confirms the secret from /2fa/enroll with a code from the authenticator app and enables 2FA. The recovery codes are shown only this once.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorCodeReq true "Code"
+// @Success 200 {object} models.RecoveryCodesResp
+// @Failure 400 {object} string "Invalid request payload"
+// @Failure 401 {object} string "Incorrect code"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /2fa/confirm [post]
+func (h *HTTPHandler) ConfirmTwoFactor(c *gin.Context) {
+	var req models.TwoFactorCodeReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return
+	}
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+
+	if recoveryCodes, ok := h.confirm(c, userID, email, req.Code); ok {
+		c.JSON(http.StatusOK, models.RecoveryCodesResp{RecoveryCodes: recoveryCodes})
+	}
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary New recovery codes
+// @Description Replaces all recovery codes, used or not, with new ones. Requires a current code from the authenticator app.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorCodeReq true "Code"
+// @Success 200 {object} models.RecoveryCodesResp
+// @Failure 400 {object} string "Invalid request payload or 2FA is not enabled"
+// @Failure 401 {object} string "Incorrect code"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /2fa/recovery-codes [post]
+func (h *HTTPHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	userID, ok := h.verifyCurrentCode(c)
+	if !ok {
+		return
+	}
+	recoveryCodes, err := generateRecoveryCodes()
+	if err == nil {
+		err = h.TwoFactor.ReplaceRecoveryCodes(userID, recoveryCodes)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.RecoveryCodesResp{RecoveryCodes: recoveryCodes})
+}
+
+// DisableTwoFactor godoc
+// @Summary Disable 2FA
+// @Description Turns 2FA off and deletes the recovery codes. Requires a current code from the authenticator app. Admins and managers can't turn it off.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param request body models.TwoFactorCodeReq true "Code"
+// @Success 200 {object} string "Two-factor authentication disabled"
+// @Failure 400 {object} string "Invalid request payload or 2FA is not enabled"
+// @Failure 401 {object} string "Incorrect code"
+// @Failure 403 {object} string "2FA is mandatory for this role"
+// @Failure 429 {object} string "Too many attempts"
+// @Failure 500 {object} string "Server error"
+// @Security BearerAuth
+// @Router /2fa/disable [post]
+func (h *HTTPHandler) DisableTwoFactor(c *gin.Context) {
+	role, ok := h.currentRole(c)
+	if !ok {
+		return
+	}
+	required, err := h.US.UM.TwoFactorRequired(role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	if required {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Two-factor authentication is mandatory for " + role + "s"})
+		return
+	}
+	userID, ok := h.verifyCurrentCode(c)
+	if !ok {
+		return
+	}
+	if err := h.TwoFactor.Disable(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+func (h *HTTPHandler) enroll(c *gin.Context, userID, email string) {
+	secret, err := totp.GenerateSecret()
+	if err == nil {
+		err = h.TwoFactor.SetPending(userID, secret)
+	}
+	if errors.Is(err, managers.ErrTwoFactorEnabled) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.TwoFactorEnrollResp{
+		Secret:     secret,
+		OtpauthURI: totp.URI(h.TOTPIssuer, email, secret),
+	})
+}
+
+// confirm enables the pending secret if code matches it and returns the new
+// recovery codes. If it can't, the request has already been answered.
+func (h *HTTPHandler) confirm(c *gin.Context, userID, email, code string) ([]string, bool) {
+	if !h.throttle(c, "2fa-confirm", email) {
+		return nil, false
+	}
+	state, err := h.TwoFactor.State(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return nil, false
+	}
+	if state.Enabled {
+		c.JSON(http.StatusConflict, gin.H{"error": managers.ErrTwoFactorEnabled.Error()})
+		return nil, false
+	}
+	if state.Secret == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": managers.ErrNoPendingSecret.Error()})
+		return nil, false
+	}
+
+	ok, err := h.checkTOTP(userID, state.Secret, code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return nil, false
+	}
+	if !ok {
+		h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Incorrect two-factor code"})
+		return nil, false
+	}
+
+	recoveryCodes, err := generateRecoveryCodes()
+	if err == nil {
+		err = h.TwoFactor.Enable(userID, recoveryCodes)
+	}
+	if errors.Is(err, managers.ErrNoPendingSecret) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return nil, false
+	}
+	h.Limits.Succeed(limitKey(email))
+	return recoveryCodes, true
+}
+
+// verifyCurrentCode checks the TOTP code in the body for the authenticated
+// user, for actions that change an enabled 2FA setup.
+func (h *HTTPHandler) verifyCurrentCode(c *gin.Context) (string, bool) {
+	var req models.TwoFactorCodeReq
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"Invalid request payload": err.Error()})
+		return "", false
+	}
+	claims := c.MustGet("claims").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+	if !h.throttle(c, "2fa-verify", email) {
+		return "", false
+	}
+
+	state, err := h.TwoFactor.State(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return "", false
+	}
+	if !state.Enabled {
+		c.JSON(http.StatusBadRequest, gin.H{"error": managers.ErrTwoFactorNotEnabled.Error()})
+		return "", false
+	}
+	ok, err := h.checkTOTP(userID, state.Secret, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return "", false
+	}
+	if !ok {
+		h.fail(c, email, http.StatusUnauthorized, gin.H{"error": "Incorrect two-factor code"})
+		return "", false
+	}
+	h.Limits.Succeed(limitKey(email))
+	return userID, true
+}
+
+// checkTOTP matches code against secret, refusing a code that was already used.
+func (h *HTTPHandler) checkTOTP(userID, secret, code string) (bool, error) {
+	step, ok := totp.Match(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return h.TwoFactor.UseStep(userID, step, time.Duration(2*totp.Skew+1)*totp.Period)
+}
+
+// spendChallenge makes a challenge token single-use. If it was already spent,
+// the request has been answered.
+func (h *HTTPHandler) spendChallenge(c *gin.Context, claims jwt.MapClaims) bool {
+	jti, _ := claims["jti"].(string)
+	fresh, err := h.TwoFactor.UseChallenge(jti, token.ChallengeTTL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error", "err": err.Error()})
+		return false
+	}
+	if !fresh {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid challenge token", "details": "challenge token was already used"})
+		return false
+	}
+	return true
+}
+
+func challengeUser(claims jwt.MapClaims) (userID, email string) {
+	userID, _ = claims["user_id"].(string)
+	email, _ = claims["email"].(string)
+	return userID, email
+}
+
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := range codes {
+		code := make([]byte, 11)
+		for j := range code {
+			if j == 5 {
+				code[j] = '-'
+				continue
+			}
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, err
+			}
+			code[j] = recoveryCodeAlphabet[n.Int64()]
+		}
+		codes[i] = string(code)
+	}
+	return codes, nil
+}
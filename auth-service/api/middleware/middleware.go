@@ -2,12 +2,14 @@ package middleware
 
 import (
 	"auth-service/api/token"
+	"auth-service/storage/managers"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
-func JWTMiddleware() gin.HandlerFunc {
+// JWTMiddleware accepts only access tokens whose session has not been revoked.
+func JWTMiddleware(sessions *managers.SessionManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -15,19 +17,26 @@ func JWTMiddleware() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		valid, err := token.ValidateToken(authHeader)
-		if err != nil || !valid {
+
+		claims, err := token.ExtractTyped(authHeader, token.TypeAccess)
+		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token", "details": err.Error()})
 			c.Abort()
 			return
 		}
 
-		claims, err := token.ExtractClaim(authHeader)
+		active, err := sessions.Exists(claims["sid"].(string))
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims", "details": err.Error()})
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Couldn't check session", "details": err.Error()})
+			c.Abort()
+			return
+		}
+		if !active {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Session revoked, please log in again"})
 			c.Abort()
 			return
 		}
+
 		c.Set("claims", claims)
 		c.Next()
 	}
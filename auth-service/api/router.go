@@ -23,13 +23,31 @@ func NewRouter(h *handlers.HTTPHandler) *gin.Engine {
 	router.POST("/register", h.Register)
 	router.POST("/confirm-registration", h.ConfirmRegistration)
 	router.POST("/login", h.Login)
+	router.POST("/login/2fa", h.LoginTwoFactor)
+	router.POST("/login/2fa/enroll", h.EnrollTwoFactorAtLogin)
+	router.POST("/login/2fa/confirm", h.ConfirmTwoFactorAtLogin)
 	router.POST("/forgot-password", h.ForgotPassword)
 	router.POST("/recover-password", h.RecoverPassword)
+	router.POST("/refresh", h.Refresh)
+	router.POST("/restore-account", h.RestoreAccount)
+	router.GET("/.well-known/jwks.json", h.JWKS)
 
-	protected := router.Group("/", middleware.JWTMiddleware())
+	protected := router.Group("/", middleware.JWTMiddleware(h.Sessions))
 	protected.GET("/profile", h.Profile)
-
-	router.GET("/user/:id", h.GetByID)
+	protected.POST("/logout", h.Logout)
+	protected.POST("/logout-all", h.LogoutAll)
+	protected.POST("/change-password", h.ChangePassword)
+	protected.POST("/change-email", h.ChangeEmail)
+	protected.POST("/confirm-email-change", h.ConfirmEmailChange)
+	protected.DELETE("/account", h.DeleteAccount)
+	protected.POST("/2fa/enroll", h.EnrollTwoFactor)
+	protected.POST("/2fa/confirm", h.ConfirmTwoFactor)
+	protected.POST("/2fa/recovery-codes", h.RegenerateRecoveryCodes)
+	protected.POST("/2fa/disable", h.DisableTwoFactor)
+	protected.GET("/user/:id", h.GetByID)
+	protected.POST("/export", h.RequestExport)
+	protected.GET("/export/:id", h.GetExport)
+	protected.GET("/export/:id/download", h.DownloadExport)
 
 	router.GET("")
 
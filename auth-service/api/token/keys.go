@@ -0,0 +1,186 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// signingKey is an RSA key identified by its file name. The newest key signs
+// new tokens; older keys only verify, and only until the grace window after
+// they were superseded has passed, which covers the lifetime of every token
+// they signed.
+type signingKey struct {
+	ID        string
+	Private   *rsa.PrivateKey
+	AddedAt   time.Time
+	RetiresAt time.Time // zero for the active key
+}
+
+type keyStore struct {
+	mu     sync.RWMutex
+	dir    string
+	grace  time.Duration
+	active *signingKey
+	keys   map[string]*signingKey
+}
+
+var keys = &keyStore{keys: map[string]*signingKey{}}
+
+// LoadKeys reads the RSA keys in dir ("<kid>.pem", PKCS#1 or PKCS#8) and
+// re-reads them every reloadEvery, so a key is rotated by dropping a newer
+// file next to the current one. If dir holds no keys, one is generated so a
+// fresh checkout can start.
+func LoadKeys(dir string, grace, reloadEvery time.Duration) error {
+	keys.dir, keys.grace = dir, grace
+	if err := keys.reload(); err != nil {
+		return err
+	}
+	go func() {
+		for range time.Tick(reloadEvery) {
+			if err := keys.reload(); err != nil {
+				log.Printf("failed to reload signing keys: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *keyStore) reload() error {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		path, err := generateKey(s.dir)
+		if err != nil {
+			return err
+		}
+		paths = []string{path}
+	}
+
+	loaded := make([]*signingKey, 0, len(paths))
+	for _, path := range paths {
+		key, err := readKey(path)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %s", path, err.Error())
+		}
+		loaded = append(loaded, key)
+	}
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].AddedAt.After(loaded[j].AddedAt) })
+
+	now := time.Now()
+	byID := map[string]*signingKey{loaded[0].ID: loaded[0]}
+	for i := 1; i < len(loaded); i++ {
+		loaded[i].RetiresAt = loaded[i-1].AddedAt.Add(s.grace)
+		if loaded[i].RetiresAt.After(now) {
+			byID[loaded[i].ID] = loaded[i]
+		}
+	}
+
+	s.mu.Lock()
+	s.active, s.keys = loaded[0], byID
+	s.mu.Unlock()
+	return nil
+}
+
+func readKey(path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	private, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{
+		ID:      strings.TrimSuffix(filepath.Base(path), ".pem"),
+		Private: private,
+		AddedAt: info.ModTime(),
+	}, nil
+}
+
+func generateKey(dir string) (string, error) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, time.Now().UTC().Format("20060102T150405")+".pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(private)})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", err
+	}
+	log.Printf("no signing keys found, generated %s", path)
+	return path, nil
+}
+
+func (s *keyStore) signer() *signingKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.active
+}
+
+func (s *keyStore) verifier(kid string) (*rsa.PublicKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key " + kid)
+	}
+	return &key.Private.PublicKey, nil
+}
+
+// JWK is a public key in the JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicKeys lists every key that tokens may currently be verified with.
+func PublicKeys() JWKS {
+	keys.mu.RLock()
+	defer keys.mu.RUnlock()
+	res := JWKS{Keys: []JWK{}}
+	for _, key := range keys.keys {
+		public := key.Private.PublicKey
+		res.Keys = append(res.Keys, JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Kid: key.ID,
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		})
+	}
+	sort.Slice(res.Keys, func(i, j int) bool { return res.Keys[i].Kid < res.Keys[j].Kid })
+	return res
+}
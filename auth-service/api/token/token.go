@@ -6,10 +6,22 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 )
 
 const (
-	signingKey = "mrbek"
+	TypeAccess    = "access"
+	TypeRefresh   = "refresh"
+	TypeChallenge = "challenge"
+
+	// A challenge token proves the password was right and says what the
+	// second login step is: entering a TOTP code or setting 2FA up.
+	ChallengeTwoFactor = "2fa"
+	ChallengeSetup     = "2fa_setup"
+
+	AccessTTL    = 180 * time.Minute
+	RefreshTTL   = 24 * time.Hour
+	ChallengeTTL = 5 * time.Minute
 )
 
 type Tokens struct {
@@ -17,28 +29,42 @@ type Tokens struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-func GenerateJWTToken(userID string, email string, role string) *Tokens {
-	accessToken := jwt.New(jwt.SigningMethodHS256)
-	refreshToken := jwt.New(jwt.SigningMethodHS256)
+// GenerateJWTToken issues an access and a refresh token for a session. Both
+// carry the session id ("sid"), so revoking the session revokes both, and
+// refreshID becomes the refresh token's "jti", so a refresh token can only be
+// exchanged once.
+func GenerateJWTToken(userID, email, role, sessionID, refreshID string) *Tokens {
+	now := time.Now()
+	key := keys.signer()
 
+	accessToken := jwt.New(jwt.SigningMethodRS256)
+	accessToken.Header["kid"] = key.ID
 	claims := accessToken.Claims.(jwt.MapClaims)
 	claims["user_id"] = userID
 	claims["email"] = email
 	claims["role"] = role
-	claims["iat"] = time.Now().Unix()
-	claims["exp"] = time.Now().Add(180 * time.Minute).Unix() // Token expires in 3 minutes
-	access, err := accessToken.SignedString([]byte(signingKey))
+	claims["type"] = TypeAccess
+	claims["jti"] = uuid.NewString()
+	claims["sid"] = sessionID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(AccessTTL).Unix()
+	access, err := accessToken.SignedString(key.Private)
 	if err != nil {
 		log.Fatal("error while generating access token : ", err)
 	}
 
+	refreshToken := jwt.New(jwt.SigningMethodRS256)
+	refreshToken.Header["kid"] = key.ID
 	rftClaims := refreshToken.Claims.(jwt.MapClaims)
 	rftClaims["user_id"] = userID
 	rftClaims["email"] = email
 	rftClaims["role"] = role
-	rftClaims["iat"] = time.Now().Unix()
-	rftClaims["exp"] = time.Now().Add(24 * time.Hour).Unix() // Refresh token expires in 24 hours
-	refresh, err := refreshToken.SignedString([]byte(signingKey))
+	rftClaims["type"] = TypeRefresh
+	rftClaims["jti"] = refreshID
+	rftClaims["sid"] = sessionID
+	rftClaims["iat"] = now.Unix()
+	rftClaims["exp"] = now.Add(RefreshTTL).Unix()
+	refresh, err := refreshToken.SignedString(key.Private)
 	if err != nil {
 		log.Fatal("error while generating refresh token : ", err)
 	}
@@ -49,6 +75,30 @@ func GenerateJWTToken(userID string, email string, role string) *Tokens {
 	}
 }
 
+// GenerateChallenge issues the short-lived token that carries a login from the
+// password step to the second factor step.
+func GenerateChallenge(userID, email, role, purpose string) string {
+	now := time.Now()
+	key := keys.signer()
+
+	challenge := jwt.New(jwt.SigningMethodRS256)
+	challenge.Header["kid"] = key.ID
+	claims := challenge.Claims.(jwt.MapClaims)
+	claims["user_id"] = userID
+	claims["email"] = email
+	claims["role"] = role
+	claims["type"] = TypeChallenge
+	claims["purpose"] = purpose
+	claims["jti"] = uuid.NewString()
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(ChallengeTTL).Unix()
+	signed, err := challenge.SignedString(key.Private)
+	if err != nil {
+		log.Fatal("error while generating challenge token : ", err)
+	}
+	return signed
+}
+
 func ValidateToken(tokenStr string) (bool, error) {
 	_, err := ExtractClaim(tokenStr)
 	if err != nil {
@@ -59,7 +109,12 @@ func ValidateToken(tokenStr string) (bool, error) {
 
 func ExtractClaim(tokenStr string) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		return []byte(signingKey), nil
+		// Only RS256 is accepted, so a token can't pick a weaker algorithm.
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.New("unexpected signing method " + token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return keys.verifier(kid)
 	})
 	if err != nil {
 		return nil, errors.New("parsing token:" + err.Error())
@@ -75,3 +130,34 @@ func ExtractClaim(tokenStr string) (jwt.MapClaims, error) {
 
 	return claims, nil
 }
+
+// ExtractTyped is ExtractClaim for a token that must be of the given type, so
+// an access token can't be used as a refresh token and vice versa.
+func ExtractTyped(tokenStr, tokenType string) (jwt.MapClaims, error) {
+	claims, err := ExtractClaim(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if t, _ := claims["type"].(string); t != tokenType {
+		return nil, errors.New("expected a " + tokenType + " token")
+	}
+	if tokenType == TypeChallenge {
+		return claims, nil
+	}
+	if sid, _ := claims["sid"].(string); sid == "" {
+		return nil, errors.New("token has no session")
+	}
+	return claims, nil
+}
+
+// ExtractChallenge is ExtractTyped for a challenge token issued for purpose.
+func ExtractChallenge(tokenStr, purpose string) (jwt.MapClaims, error) {
+	claims, err := ExtractTyped(tokenStr, TypeChallenge)
+	if err != nil {
+		return nil, err
+	}
+	if p, _ := claims["purpose"].(string); p != purpose {
+		return nil, errors.New("challenge token is not for " + purpose)
+	}
+	return claims, nil
+}
@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileMailer writes every message to its own file in dir instead of sending
+// it, so the emails of a local run can be read from disk.
+type FileMailer struct {
+	dir string
+}
+
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileMailer{dir: dir}, nil
+}
+
+func (m *FileMailer) Send(msg Message) error {
+	name := fmt.Sprintf("%s_%s_%s.eml", time.Now().UTC().Format("20060102T150405"),
+		strings.NewReplacer("@", "_at_", "/", "_").Replace(msg.To), uuid.NewString()[:8])
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "To: %s\nSubject: %s\n\n", msg.To, msg.Subject)
+	if msg.Text != "" {
+		fmt.Fprintf(&b, "%s\n", msg.Text)
+	}
+	if msg.HTML != "" {
+		fmt.Fprintf(&b, "\n--- text/html ---\n%s\n", msg.HTML)
+	}
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(b.String()), 0o644)
+}
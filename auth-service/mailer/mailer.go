@@ -0,0 +1,33 @@
+package mailer
+
+import (
+	"errors"
+)
+
+// Message is an email ready to be sent. Text is the plain-text alternative of
+// HTML; either may be empty.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer delivers messages. SMTPMailer sends them for real, FileMailer drops
+// them in a directory and MemoryMailer keeps them, for running offline.
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// New returns the mailer named by kind: "smtp", "file" or "memory".
+func New(kind string, smtp SMTPConfig, dir string) (Mailer, error) {
+	switch kind {
+	case "smtp":
+		return NewSMTPMailer(smtp), nil
+	case "file":
+		return NewFileMailer(dir)
+	case "memory":
+		return NewMemoryMailer(), nil
+	}
+	return nil, errors.New("unknown mailer " + kind)
+}
@@ -0,0 +1,27 @@
+package mailer
+
+import "sync"
+
+// MemoryMailer keeps sent messages in memory.
+type MemoryMailer struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// Sent returns a copy of every message sent so far.
+func (m *MemoryMailer) Sent() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.sent...)
+}
@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"errors"
+	"log"
+	"time"
+)
+
+var ErrQueueFull = errors.New("mail queue is full")
+
+// Queue sends messages in the background, so a request doesn't wait for, or
+// fail with, a slow mail server. A failed send is retried with exponential
+// backoff, up to maxAttempts times in total.
+type Queue struct {
+	mailer      Mailer
+	jobs        chan job
+	maxAttempts int
+	backoff     time.Duration
+}
+
+type job struct {
+	msg     Message
+	attempt int
+}
+
+func NewQueue(mailer Mailer, workers, size, maxAttempts int, backoff time.Duration) *Queue {
+	q := &Queue{mailer: mailer, jobs: make(chan job, size), maxAttempts: maxAttempts, backoff: backoff}
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// Enqueue schedules msg for sending. It only fails if the queue is full.
+func (q *Queue) Enqueue(msg Message) error {
+	select {
+	case q.jobs <- job{msg: msg, attempt: 1}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (q *Queue) work() {
+	for j := range q.jobs {
+		err := q.mailer.Send(j.msg)
+		if err == nil {
+			continue
+		}
+		if j.attempt >= q.maxAttempts {
+			log.Printf("giving up sending %q to %s after %d attempts: %v", j.msg.Subject, j.msg.To, j.attempt, err)
+			continue
+		}
+		delay := q.backoff << (j.attempt - 1)
+		log.Printf("failed to send %q to %s (attempt %d), retrying in %s: %v", j.msg.Subject, j.msg.To, j.attempt, delay, err)
+		next := job{msg: j.msg, attempt: j.attempt + 1}
+		time.AfterFunc(delay, func() {
+			select {
+			case q.jobs <- next:
+			default:
+				log.Printf("mail queue is full, dropping %q to %s", next.msg.Subject, next.msg.To)
+			}
+		})
+	}
+}
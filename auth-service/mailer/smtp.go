@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"gopkg.in/gomail.v2"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type SMTPMailer struct {
+	from   string
+	dialer *gomail.Dialer
+}
+
+func NewSMTPMailer(cf SMTPConfig) *SMTPMailer {
+	return &SMTPMailer{from: cf.From, dialer: gomail.NewDialer(cf.Host, cf.Port, cf.Username, cf.Password)}
+}
+
+func (m *SMTPMailer) Send(msg Message) error {
+	gm := gomail.NewMessage()
+	gm.SetHeader("From", m.from)
+	gm.SetHeader("To", msg.To)
+	gm.SetHeader("Subject", msg.Subject)
+	switch {
+	case msg.Text != "" && msg.HTML != "":
+		gm.SetBody("text/plain", msg.Text)
+		gm.AddAlternative("text/html", msg.HTML)
+	case msg.HTML != "":
+		gm.SetBody("text/html", msg.HTML)
+	default:
+		gm.SetBody("text/plain", msg.Text)
+	}
+	return m.dialer.DialAndSend(gm)
+}
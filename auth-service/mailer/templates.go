@@ -0,0 +1,88 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	htemplate "html/template"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	ttemplate "text/template"
+)
+
+// Every email has a <locale>/<name>.txt and a <locale>/<name>.html for each
+// locale. The .txt one also defines the "subject" template.
+//
+//go:embed templates
+var templateFS embed.FS
+
+const DefaultLocale = "uz"
+
+var locales = []string{"uz", "ru", "en"}
+
+var (
+	textTemplates = map[string]*ttemplate.Template{}
+	htmlTemplates = map[string]*htemplate.Template{}
+)
+
+// Templates are parsed one file at a time, as every .txt defines its own
+// "subject". They are keyed by "<locale>/<name>".
+func init() {
+	for _, locale := range locales {
+		paths, _ := fs.Glob(templateFS, "templates/"+locale+"/*")
+		for _, path := range paths {
+			name := locale + "/" + strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+			switch filepath.Ext(path) {
+			case ".txt":
+				textTemplates[name] = ttemplate.Must(ttemplate.ParseFS(templateFS, path))
+			case ".html":
+				htmlTemplates[name] = htemplate.Must(htemplate.ParseFS(templateFS, path))
+			}
+		}
+	}
+}
+
+// Locale returns the first supported locale among the candidates, which can
+// be locale names like "ru" or Accept-Language headers like "ru-RU,ru;q=0.9".
+// Candidates are tried in order, so the user's saved locale should go first.
+func Locale(candidates ...string) string {
+	for _, candidate := range candidates {
+		for _, part := range strings.Split(candidate, ",") {
+			tag := strings.ToLower(strings.TrimSpace(strings.SplitN(part, ";", 2)[0]))
+			tag = strings.SplitN(tag, "-", 2)[0]
+			for _, locale := range locales {
+				if tag == locale {
+					return locale
+				}
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// Render builds the message called name in locale for to.
+func Render(name, locale, to string, data any) (Message, error) {
+	key := Locale(locale) + "/" + name
+	textTmpl, htmlTmpl := textTemplates[key], htmlTemplates[key]
+	if textTmpl == nil || htmlTmpl == nil {
+		return Message{}, errors.New("no email template " + key)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := textTmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := textTmpl.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+	if err := htmlTmpl.Execute(&html, data); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()),
+		HTML:    html.String(),
+	}, nil
+}
@@ -3,10 +3,20 @@ package main
 import (
 	"auth-service/api"
 	"auth-service/api/handlers"
+	"auth-service/api/token"
 	"auth-service/config"
+	pb "auth-service/genprotos"
+	"auth-service/mailer"
 	"auth-service/service"
 	"auth-service/storage"
+	"auth-service/storage/managers"
 	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -17,10 +27,64 @@ func main() {
 	em.CheckErr(err)
 	defer pgsql.Close()
 
+	err = token.LoadKeys(cf.JWT_KEYS_DIR, cf.JWT_KEY_GRACE, cf.JWT_KEYS_RELOAD)
+	em.CheckErr(err)
+
+	rdb, err := storage.ConnectRedis(&cf)
+	em.CheckErr(err)
+	defer rdb.Close()
+
 	us := service.NewUserService(pgsql, mongo)
-	handler := handlers.NewHandler(us)
+	go us.PurgeDeletions(cf.ACCOUNT_PURGE_EVERY)
+	go us.ExpireBans(cf.BAN_EXPIRE_EVERY)
+	sessions := managers.NewSessionManager(rdb)
+	limits := managers.NewLimitManager(rdb, managers.LimitPolicy{
+		PerIP:            cf.RATE_LIMIT_PER_IP,
+		PerEmail:         cf.RATE_LIMIT_PER_EMAIL,
+		Window:           cf.RATE_LIMIT_WINDOW,
+		LockoutThreshold: cf.LOCKOUT_THRESHOLD,
+		LockoutBase:      cf.LOCKOUT_BASE,
+		LockoutMax:       cf.LOCKOUT_MAX,
+		CodeAttempts:     cf.CODE_MAX_ATTEMPTS,
+	})
+	codes := managers.NewCodeManager(rdb, cf.CODE_SECRET, map[managers.Purpose]time.Duration{
+		managers.PurposeRegistration:  cf.CODE_TTL_REGISTRATION,
+		managers.PurposePasswordReset: cf.CODE_TTL_PASSWORD_RESET,
+		managers.PurposeEmailChange:   cf.CODE_TTL_EMAIL_CHANGE,
+	})
+	mail, err := mailer.New(cf.MAILER, mailer.SMTPConfig{
+		Host:     cf.SMTP_HOST,
+		Port:     cf.SMTP_PORT,
+		Username: cf.SENDER_EMAIL,
+		Password: cf.APP_PASSWORD,
+		From:     cf.SENDER_EMAIL,
+	}, cf.MAIL_DIR)
+	em.CheckErr(err)
+	mailQueue := mailer.NewQueue(mail, cf.MAIL_WORKERS, cf.MAIL_QUEUE_SIZE, cf.MAIL_MAX_ATTEMPTS, cf.MAIL_RETRY_BACKOFF)
+	twoFactor := managers.NewTwoFactorManager(pgsql, rdb, cf.TOTP_KEY)
+
+	OrderConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", cf.ORDER_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer OrderConn.Close()
+	exports, err := service.NewExportService(us, managers.NewExportManager(rdb, cf.EXPORT_TTL), OrderConn, mailQueue, cf.EXPORT_DIR, cf.EXPORT_BASE_URL, cf.EXPORT_WORKERS)
+	em.CheckErr(err)
+	go exports.RemoveExpired(cf.EXPORT_TTL, time.Hour)
+
+	handler := handlers.NewHandler(us, sessions, limits, codes, mailQueue, twoFactor, exports, cf.TOTP_ISSUER, cf.ACCOUNT_DELETE_GRACE)
+
+	listener, err := net.Listen("tcp", cf.AUTH_GRPC_PORT)
+	em.CheckErr(err)
+	s := grpc.NewServer()
+	pb.RegisterAuthServiceServer(s, service.NewAuthService(us, sessions))
+	go func() {
+		log.Printf("gRPC server listening at %v", listener.Addr())
+		if err := s.Serve(listener); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
 
 	roter := api.NewRouter(handler)
+	em.CheckErr(roter.SetTrustedProxies(cf.TRUSTED_PROXIES))
 	fmt.Println("Server is running on port ", cf.AUTH_PORT)
 	if err := roter.Run(cf.AUTH_PORT); err != nil {
 		panic(err)
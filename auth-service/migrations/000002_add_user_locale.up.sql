@@ -0,0 +1,2 @@
+-- Language of the emails sent to the user: 'uz', 'ru' or 'en'
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale VARCHAR(2) NOT NULL DEFAULT 'uz';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS account_audit;
+DROP TABLE IF EXISTS account_deletions;
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
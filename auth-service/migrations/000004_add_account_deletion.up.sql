@@ -0,0 +1,23 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- What is needed to undo a deletion. email and user_data are cleared when the
+-- grace period ends, which makes the deletion final.
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR(255),
+    user_data JSONB,
+    deleted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    restore_until TIMESTAMP NOT NULL,
+    purged_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS account_audit (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    action VARCHAR(64) NOT NULL,
+    ip VARCHAR(64),
+    details JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS account_audit_user_id_idx ON account_audit (user_id, created_at);
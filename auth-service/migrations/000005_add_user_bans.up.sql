@@ -0,0 +1,18 @@
+-- A user is banned while they have a ban that hasn't been lifted. Their role is
+-- 'banned' meanwhile and previous_role is what they get back afterwards.
+CREATE TABLE IF NOT EXISTS user_bans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    previous_role user_role NOT NULL,
+    reason TEXT NOT NULL,
+    notes TEXT,
+    issued_by UUID,
+    starts_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP, -- NULL for a permanent ban
+    lifted_at TIMESTAMP,
+    lifted_by UUID, -- NULL when the ban expired
+    lift_reason TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_bans_active_idx ON user_bans (user_id) WHERE lifted_at IS NULL;
+CREATE INDEX IF NOT EXISTS user_bans_user_id_idx ON user_bans (user_id, starts_at);
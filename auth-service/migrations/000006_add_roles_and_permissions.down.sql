@@ -0,0 +1,12 @@
+-- Fails while anyone has a custom role; move them to a built-in one first.
+CREATE TYPE user_role AS ENUM ('admin', 'user', 'courier', 'manager', 'banned');
+
+ALTER TABLE user_bans DROP CONSTRAINT IF EXISTS user_bans_previous_role_fkey;
+ALTER TABLE user_bans ALTER COLUMN previous_role TYPE user_role USING previous_role::user_role;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_fkey;
+ALTER TABLE users ALTER COLUMN role TYPE user_role USING role::user_role;
+
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS permissions;
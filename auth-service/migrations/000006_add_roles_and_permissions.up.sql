@@ -0,0 +1,57 @@
+-- Roles become rows instead of enum values, so admins can add roles without a
+-- migration. What a role may do is the set of permissions granted to it.
+CREATE TABLE IF NOT EXISTS permissions (
+    name VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    builtin BOOLEAN NOT NULL DEFAULT FALSE, -- built-in roles can't be deleted
+    two_factor_required BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(64) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission VARCHAR(64) NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('product:write', 'Add products and change their prices'),
+    ('order:read', 'See any order and its status history'),
+    ('order:update', 'Move orders to another status'),
+    ('order:refund', 'Refund orders'),
+    ('user:read', 'List users and see their private profile fields'),
+    ('user:ban', 'Ban and unban users and see their ban history'),
+    ('staff:manage', 'Add and remove couriers and product managers'),
+    ('role:manage', 'Create, change and assign roles')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO roles (name, description, builtin, two_factor_required) VALUES
+    ('admin', 'Full access', TRUE, TRUE),
+    ('manager', 'Manages products and orders', TRUE, TRUE),
+    ('courier', 'Delivers orders', TRUE, FALSE),
+    ('user', 'Customer', TRUE, FALSE),
+    ('banned', 'Banned account', TRUE, FALSE)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+    SELECT 'admin', name FROM permissions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('manager', 'product:write'),
+    ('manager', 'order:read'),
+    ('manager', 'order:update')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE users ALTER COLUMN role TYPE VARCHAR(64) USING role::text;
+ALTER TABLE users ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
+
+ALTER TABLE user_bans ALTER COLUMN previous_role TYPE VARCHAR(64) USING previous_role::text;
+ALTER TABLE user_bans ADD CONSTRAINT user_bans_previous_role_fkey FOREIGN KEY (previous_role) REFERENCES roles(name) ON UPDATE CASCADE;
+
+DROP TYPE IF EXISTS user_role;
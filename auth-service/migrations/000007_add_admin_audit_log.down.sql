@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name = 'audit:read';
+DROP TABLE IF EXISTS admin_audit_log;
+DROP FUNCTION IF EXISTS admin_audit_log_append_only();
@@ -0,0 +1,41 @@
+-- Privileged changes made through the admin and product manager gateways.
+-- Rows can only be added, never changed or removed.
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id UUID,
+    actor_role VARCHAR(64),
+    source VARCHAR(32) NOT NULL, -- the gateway the change came through: admin or pm
+    action VARCHAR(64) NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id VARCHAR(255),
+    before JSONB,
+    after JSONB,
+    ip VARCHAR(64),
+    user_agent TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_created_at_idx ON admin_audit_log (created_at);
+CREATE INDEX IF NOT EXISTS admin_audit_log_actor_idx ON admin_audit_log (actor_id, created_at);
+CREATE INDEX IF NOT EXISTS admin_audit_log_target_idx ON admin_audit_log (target_type, target_id, created_at);
+
+CREATE OR REPLACE FUNCTION admin_audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER admin_audit_log_no_update_delete
+    BEFORE UPDATE OR DELETE ON admin_audit_log
+    FOR EACH ROW EXECUTE FUNCTION admin_audit_log_append_only();
+
+CREATE TRIGGER admin_audit_log_no_truncate
+    BEFORE TRUNCATE ON admin_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION admin_audit_log_append_only();
+
+INSERT INTO permissions (name, description) VALUES
+    ('audit:read', 'See and export the audit log of privileged changes')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES ('admin', 'audit:read')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name IN ('courier:review', 'courier:work');
+DROP TABLE IF EXISTS courier_documents;
+DROP TABLE IF EXISTS courier_profiles;
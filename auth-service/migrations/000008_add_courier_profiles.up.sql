@@ -0,0 +1,46 @@
+-- Couriers go through onboarding before they can deliver:
+-- invited -> documents_submitted -> approved -> active, and active couriers can
+-- be suspended. A rejected submission goes back to invited with a reason.
+CREATE TABLE IF NOT EXISTS courier_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    full_name VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(32) NOT NULL DEFAULT '',
+    vehicle_type VARCHAR(16) CHECK (vehicle_type IN ('foot', 'bicycle', 'scooter', 'car')),
+    capacity_kg NUMERIC(6, 2) NOT NULL DEFAULT 0,
+    status VARCHAR(32) NOT NULL DEFAULT 'invited'
+        CHECK (status IN ('invited', 'documents_submitted', 'approved', 'active', 'suspended')),
+    status_reason TEXT, -- why the last submission was rejected or the courier suspended
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP,
+    submitted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS courier_profiles_status_idx ON courier_profiles (status);
+
+-- One current document of each kind per courier; uploading again replaces it.
+CREATE TABLE IF NOT EXISTS courier_documents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES courier_profiles(user_id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('id_card', 'driver_license', 'vehicle_registration', 'insurance')),
+    object_key TEXT NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    uploaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, kind)
+);
+
+INSERT INTO courier_profiles (user_id)
+    SELECT id FROM users WHERE role = 'courier'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO permissions (name, description) VALUES
+    ('courier:review', 'Approve, reject, suspend and reinstate couriers'),
+    ('courier:work', 'Use the courier gateway: onboarding and deliveries')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'courier:review'),
+    ('courier', 'courier:work')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP VIEW IF EXISTS online_couriers;
+DROP TABLE IF EXISTS courier_shifts;
@@ -0,0 +1,22 @@
+-- A shift is the time a courier is working. Within a shift they go online to
+-- take deliveries and offline for breaks: online_since marks the current online
+-- stretch and online_seconds adds up the finished ones.
+CREATE TABLE IF NOT EXISTS courier_shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES courier_profiles(user_id) ON DELETE CASCADE,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at TIMESTAMP,
+    end_reason VARCHAR(16) CHECK (end_reason IN ('courier', 'suspended', 'timeout')),
+    online_since TIMESTAMP,
+    online_seconds BIGINT NOT NULL DEFAULT 0,
+    CHECK (ended_at IS NULL OR online_since IS NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS courier_shifts_open_idx ON courier_shifts (user_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS courier_shifts_user_idx ON courier_shifts (user_id, started_at DESC);
+
+-- Couriers dispatch may offer deliveries to.
+CREATE OR REPLACE VIEW online_couriers AS
+    SELECT p.user_id, p.vehicle_type, p.capacity_kg, s.id AS shift_id, s.started_at AS shift_started_at, s.online_since
+    FROM courier_profiles p JOIN courier_shifts s ON s.user_id = p.user_id AND s.ended_at IS NULL
+    WHERE p.status = 'active' AND s.online_since IS NOT NULL;
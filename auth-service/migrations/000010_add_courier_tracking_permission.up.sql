@@ -0,0 +1,7 @@
+-- Courier positions themselves live in Redis, written by the courier gateway.
+INSERT INTO permissions (name, description) VALUES
+    ('courier:track', 'See where couriers are and the tracks of their deliveries')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES ('admin', 'courier:track')
+ON CONFLICT DO NOTHING;
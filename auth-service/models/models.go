@@ -1,14 +1,18 @@
 package models
 
+import "time"
+
 type RegisterReqSwag struct {
 	Email    string `json:"email"`    // User's email address
 	Password string `json:"password"` // User's password
+	Locale   string `json:"locale"`   // Language of emails: uz, ru or en; Accept-Language if empty
 }
 
 type RegisterReq struct {
 	ID       string `json:"id"`       // User's unique identifier
 	Email    string `json:"email"`    // User's email address
 	Password string `json:"password"` // User's password
+	Locale   string `json:"locale"`   // Language of emails: uz, ru or en
 	Role     string
 }
 
@@ -27,16 +31,28 @@ type GetProfileResp struct {
 	Password    string `json:"password"` // User's password
 	Role        string `json:"role"`
 	IsConfirmed bool   `json:"is_confirmed"` // Add IsConfirmed to the model
+	Locale      string `json:"locale"`
 }
 
 type GetProfileByIdReq struct {
 	ID string `json:"id"` // Username of the profile to retrieve
 }
 
+// GetProfileByIdResp is a user's profile as seen by others. Email, confirmation
+// and locale are only filled in for the user themselves and for roles that may
+// read users.
 type GetProfileByIdResp struct {
-	ID    string `json:"id"`    // User's unique identifier
-	Email string `json:"email"` // User's email address
-	Role  string `json:"role"`
+	ID          string    `json:"id"`              // User's unique identifier
+	Email       string    `json:"email,omitempty"` // User's email address
+	Role        string    `json:"role"`
+	IsConfirmed *bool     `json:"is_confirmed,omitempty"`
+	Locale      string    `json:"locale,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Public drops the fields only the user and those who may read users see.
+func (p *GetProfileByIdResp) Public() *GetProfileByIdResp {
+	return &GetProfileByIdResp{ID: p.ID, Role: p.Role, CreatedAt: p.CreatedAt}
 }
 
 type ForgotPasswordReq struct {
@@ -77,3 +93,71 @@ type Cart struct {
 	ProductID string `bson:"product_id"`
 	Quantity  int    `bson:"quantity"`
 }
+
+type RefreshReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type LoginChallengeResp struct {
+	ChallengeToken         string `json:"challenge_token"`
+	TwoFactorRequired      bool   `json:"two_factor_required"`       // Send a code to /login/2fa
+	TwoFactorSetupRequired bool   `json:"two_factor_setup_required"` // Set 2FA up through /login/2fa/enroll and /login/2fa/confirm
+}
+
+type TwoFactorLoginReq struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`          // Code from the authenticator app
+	RecoveryCode   string `json:"recovery_code"` // Or one of the recovery codes
+}
+
+type TwoFactorCodeReq struct {
+	Code string `json:"code"` // Code from the authenticator app
+}
+
+type TwoFactorSetupReq struct {
+	ChallengeToken string `json:"challenge_token"`
+}
+
+type TwoFactorSetupConfirmReq struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"` // Code from the authenticator app
+}
+
+type TwoFactorEnrollResp struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"` // Show as a QR code
+}
+
+type RecoveryCodesResp struct {
+	RecoveryCodes []string `json:"recovery_codes"` // Shown only once, each works once
+}
+
+type TwoFactorSetupResp struct {
+	AccessToken   string   `json:"access_token"`
+	RefreshToken  string   `json:"refresh_token"`
+	RecoveryCodes []string `json:"recovery_codes"` // Shown only once, each works once
+}
+
+type ChangePasswordReq struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+type ChangeEmailReq struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"` // Current password
+}
+
+type ConfirmEmailChangeReq struct {
+	NewEmail string `json:"new_email"`
+	Code     string `json:"code"` // Code sent to the new email
+}
+
+type DeleteAccountReq struct {
+	Password string `json:"password"` // Current password
+}
+
+type RestoreAccountReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"auth-service/api/token"
+	pb "auth-service/genprotos"
+	"auth-service/storage/managers"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthService lets the gateways check access tokens without verifying them
+// themselves, so signature, session and ban checks live in one place.
+type AuthService struct {
+	users    *UserService
+	sessions *managers.SessionManager
+	pb.UnimplementedAuthServiceServer
+}
+
+func NewAuthService(users *UserService, sessions *managers.SessionManager) *AuthService {
+	return &AuthService{users: users, sessions: sessions}
+}
+
+func (s *AuthService) ValidateToken(ctx context.Context, req *pb.TokenReq) (*pb.TokenInfo, error) {
+	info, err := s.Introspect(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if info.Banned {
+		return nil, status.Error(codes.PermissionDenied, info.Reason)
+	}
+	if !info.Active {
+		return nil, status.Error(codes.Unauthenticated, info.Reason)
+	}
+	return info, nil
+}
+
+func (s *AuthService) Introspect(ctx context.Context, req *pb.TokenReq) (*pb.TokenInfo, error) {
+	claims, err := token.ExtractTyped(req.Token, token.TypeAccess)
+	if err != nil {
+		return &pb.TokenInfo{Reason: err.Error()}, nil
+	}
+	info := &pb.TokenInfo{}
+	info.UserId, _ = claims["user_id"].(string)
+	info.Email, _ = claims["email"].(string)
+	info.SessionId, _ = claims["sid"].(string)
+	if exp, ok := claims["exp"].(float64); ok {
+		info.ExpiresAt = int64(exp)
+	}
+
+	info.SessionValid, err = s.sessions.Exists(info.SessionId)
+	if err != nil {
+		log.Printf("failed to check session %s: %v", info.SessionId, err)
+		return nil, status.Error(codes.Unavailable, "couldn't check session")
+	}
+
+	info.Role, err = s.users.Role(info.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		info.Reason = "user not found"
+		return info, nil
+	}
+	if err != nil {
+		log.Printf("failed to get role of user %s: %v", info.UserId, err)
+		return nil, status.Error(codes.Unavailable, "couldn't check user")
+	}
+	info.Banned = info.Role == "banned"
+	info.Permissions, err = s.users.UM.Permissions(info.Role)
+	if err != nil {
+		log.Printf("failed to get permissions of role %s: %v", info.Role, err)
+		return nil, status.Error(codes.Unavailable, "couldn't check user")
+	}
+
+	switch {
+	case !info.SessionValid:
+		info.Reason = "session revoked"
+	case info.Banned:
+		info.Reason = "user is banned"
+	default:
+		info.Active = true
+	}
+	return info, nil
+}
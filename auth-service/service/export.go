@@ -0,0 +1,290 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "auth-service/genprotos"
+	"auth-service/mailer"
+	"auth-service/storage/managers"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+const exportOrdersPage = 100
+
+// exportStaleAfter is how long an export may stay unfinished before it is
+// taken for lost, e.g. with a worker that died.
+const exportStaleAfter = time.Hour
+
+var ErrExportQueueFull = errors.New("too many exports in progress, try again later")
+
+// ExportService builds users' data exports in the background. Every export is
+// written twice to dir: <id>.json with everything in one document and
+// <id>.zip with one file per section.
+type ExportService struct {
+	users   *UserService
+	jobs    *managers.ExportManager
+	orders  pb.OrderServiceClient
+	mail    *mailer.Queue
+	dir     string
+	baseURL string
+	queue   chan string
+}
+
+func NewExportService(users *UserService, jobs *managers.ExportManager, orderConn *grpc.ClientConn, mail *mailer.Queue, dir, baseURL string, workers int) (*ExportService, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s := &ExportService{
+		users:   users,
+		jobs:    jobs,
+		orders:  pb.NewOrderServiceClient(orderConn),
+		mail:    mail,
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		queue:   make(chan string, 100),
+	}
+	for i := 0; i < workers; i++ {
+		go s.work()
+	}
+	s.resume()
+	return s, nil
+}
+
+// resume picks up the exports a restart interrupted. Pending ones are queued
+// again, running ones were cut off half way and fail.
+func (s *ExportService) resume() {
+	jobs, err := s.jobs.Unfinished()
+	if err != nil {
+		log.Printf("failed to resume data exports: %v", err)
+		return
+	}
+	for _, job := range jobs {
+		if job.Status == managers.ExportRunning {
+			s.jobs.SetStatus(job.ID, managers.ExportFailed, "interrupted by a restart, please request a new export")
+			continue
+		}
+		if err := s.enqueue(job); err != nil {
+			log.Printf("failed to resume data export %s: %v", job.ID, err)
+		}
+	}
+}
+
+// Request starts an export for the user, or returns the one still in progress.
+func (s *ExportService) Request(userID string) (*managers.ExportJob, error) {
+	latest, err := s.jobs.Latest(userID)
+	if err == nil && (latest.Status == managers.ExportPending || latest.Status == managers.ExportRunning) {
+		if time.Since(latest.CreatedAt) < exportStaleAfter {
+			return latest, nil
+		}
+		s.jobs.SetStatus(latest.ID, managers.ExportFailed, "export took too long")
+	}
+	if err != nil && !errors.Is(err, managers.ErrExportNotFound) {
+		return nil, err
+	}
+
+	job := &managers.ExportJob{ID: uuid.NewString(), UserID: userID}
+	if err := s.jobs.Create(job); err != nil {
+		return nil, err
+	}
+	if err := s.enqueue(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// enqueue hands the job to the workers, failing it if they are too far behind.
+func (s *ExportService) enqueue(job *managers.ExportJob) error {
+	select {
+	case s.queue <- job.ID:
+		return nil
+	default:
+		s.jobs.SetStatus(job.ID, managers.ExportFailed, ErrExportQueueFull.Error())
+		return ErrExportQueueFull
+	}
+}
+
+func (s *ExportService) Get(id string) (*managers.ExportJob, error) {
+	return s.jobs.Get(id)
+}
+
+// File is where a ready export is stored in format, "json" or "zip".
+func (s *ExportService) File(job *managers.ExportJob, format string) string {
+	return filepath.Join(s.dir, job.ID+"."+format)
+}
+
+func (s *ExportService) work() {
+	for id := range s.queue {
+		if err := s.run(id); err != nil {
+			log.Printf("data export %s failed: %v", id, err)
+			s.jobs.SetStatus(id, managers.ExportFailed, err.Error())
+		}
+	}
+}
+
+func (s *ExportService) run(id string) error {
+	job, err := s.jobs.Get(id)
+	if err != nil {
+		return err
+	}
+	if err := s.jobs.SetStatus(id, managers.ExportRunning, ""); err != nil {
+		return err
+	}
+
+	profile, err := s.users.UM.ExportProfile(job.UserID)
+	if err != nil {
+		return fmt.Errorf("profile: %s", err.Error())
+	}
+	document, err := s.users.UM.ExportDocument(job.UserID)
+	if err != nil {
+		return fmt.Errorf("user data: %s", err.Error())
+	}
+	orders, err := s.userOrders(job.UserID)
+	if err != nil {
+		return fmt.Errorf("orders: %s", err.Error())
+	}
+	events, err := s.users.UM.AuditLog(job.UserID)
+	if err != nil {
+		return fmt.Errorf("account events: %s", err.Error())
+	}
+
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile", profile},
+		{"user_data", document},
+		{"orders", orders},
+		{"addresses", deliveryAddresses(orders)},
+		// Product ratings are stored without the user who gave them, so there
+		// are no reviews to attribute to anyone yet.
+		{"reviews", []interface{}{}},
+		{"account_events", events},
+	}
+
+	all := map[string]interface{}{"exported_at": time.Now().UTC()}
+	for _, section := range sections {
+		all[section.name] = section.data
+	}
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(s.File(job, "json"), data, 0o600); err != nil {
+		return err
+	}
+	if err := s.writeZip(job, sections); err != nil {
+		return err
+	}
+
+	if err := s.jobs.SetStatus(id, managers.ExportReady, ""); err != nil {
+		return err
+	}
+	s.notify(job, profile)
+	return nil
+}
+
+func (s *ExportService) writeZip(job *managers.ExportJob, sections []struct {
+	name string
+	data interface{}
+}) error {
+	file, err := os.OpenFile(s.File(job, "zip"), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	for _, section := range sections {
+		w, err := archive.Create(section.name + ".json")
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(section.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func (s *ExportService) userOrders(userID string) ([]*pb.OrderGRes, error) {
+	orders := []*pb.OrderGRes{}
+	for offset := int64(0); ; offset += exportOrdersPage {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		res, err := s.orders.ListOrders(ctx, &pb.OrderGAReq{
+			UserId:     userID,
+			Pagination: &pb.Pagination{Limit: exportOrdersPage, Offset: offset},
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, res.Orders...)
+		if len(res.Orders) < exportOrdersPage {
+			return orders, nil
+		}
+	}
+}
+
+// deliveryAddresses are the distinct addresses the user ordered to, as there
+// is no separate address book.
+func deliveryAddresses(orders []*pb.OrderGRes) []string {
+	seen := map[string]bool{}
+	addresses := []string{}
+	for _, order := range orders {
+		if order.Address != "" && !seen[order.Address] {
+			seen[order.Address] = true
+			addresses = append(addresses, order.Address)
+		}
+	}
+	return addresses
+}
+
+func (s *ExportService) notify(job *managers.ExportJob, profile map[string]interface{}) {
+	email, _ := profile["email"].(string)
+	locale, _ := profile["locale"].(string)
+	msg, err := mailer.Render("data_export", locale, email, map[string]any{
+		"DownloadURL": s.baseURL + "/export/" + job.ID + "/download",
+		"ExpiresAt":   job.ExpiresAt.UTC().Format("2006-01-02 15:04 UTC"),
+	})
+	if err == nil {
+		err = s.mail.Enqueue(msg)
+	}
+	if err != nil {
+		log.Printf("failed to notify %s about export %s: %v", email, job.ID, err)
+	}
+}
+
+// RemoveExpired deletes export files older than ttl, checking every interval.
+func (s *ExportService) RemoveExpired(ttl, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		entries, err := os.ReadDir(s.dir)
+		if err != nil {
+			log.Printf("failed to list exports: %v", err)
+			continue
+		}
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil || time.Since(info.ModTime()) < ttl {
+				continue
+			}
+			if err := os.Remove(filepath.Join(s.dir, entry.Name())); err != nil {
+				log.Printf("failed to remove expired export %s: %v", entry.Name(), err)
+			}
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"auth-service/models"
 	"auth-service/storage/managers"
 	"database/sql"
+	"log"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -38,3 +40,47 @@ func (u *UserService) IsEmailExists(email string) error {
 func (u *UserService) GetByID(id *models.GetProfileByIdReq) (*models.GetProfileByIdResp, error) {
 	return u.UM.GetByID(id)
 }
+
+func (u *UserService) Role(id string) (string, error) {
+	return u.UM.Role(id)
+}
+
+// PurgeDeletions makes account deletions final once their grace period is
+// over, checking every interval.
+func (u *UserService) PurgeDeletions(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ids, err := u.UM.PurgeDeletions()
+		if err != nil {
+			log.Printf("failed to purge deleted accounts: %v", err)
+			continue
+		}
+		for _, id := range ids {
+			if err := u.UM.Audit(id, managers.AuditAccountDeletionPurged, "", nil); err != nil {
+				log.Printf("failed to audit purge of %s: %v", id, err)
+			}
+		}
+		if len(ids) > 0 {
+			log.Printf("purged %d deleted accounts", len(ids))
+		}
+	}
+}
+
+// ExpireBans lifts bans once they run out, checking every interval.
+func (u *UserService) ExpireBans(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ids, err := u.UM.ExpireBans()
+		if err != nil {
+			log.Printf("failed to expire bans: %v", err)
+			continue
+		}
+		for _, id := range ids {
+			if err := u.UM.Audit(id, managers.AuditBanExpired, "", nil); err != nil {
+				log.Printf("failed to audit ban expiry of %s: %v", id, err)
+			}
+		}
+	}
+}
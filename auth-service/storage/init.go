@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	_ "github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -34,3 +35,15 @@ func ConnectDB(cf *config.Config) (*sql.DB, *mongo.Client, error) {
 
 	return db, client, nil
 }
+
+func ConnectRedis(cf *config.Config) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cf.REDIS_ADDR,
+		Password: cf.REDIS_PASSWORD,
+		DB:       cf.REDIS_DB,
+	})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("redis not connected due to error: %s", err.Error())
+	}
+	return rdb, nil
+}
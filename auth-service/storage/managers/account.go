@@ -0,0 +1,278 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	AuditPasswordChanged       = "password_changed"
+	AuditPasswordReset         = "password_reset"
+	AuditEmailChangeRequested  = "email_change_requested"
+	AuditEmailChanged          = "email_changed"
+	AuditAccountDeleted        = "account_deleted"
+	AuditAccountRestored       = "account_restored"
+	AuditAccountDeletionPurged = "account_deletion_purged"
+	AuditDataExportRequested   = "data_export_requested"
+	AuditBanExpired            = "ban_expired"
+)
+
+var ErrNothingToRestore = errors.New("no deleted account with this email can be restored")
+
+// Audit records something that happened to the user's account.
+func (m *UserManager) Audit(userID, action, ip string, details map[string]string) error {
+	var data []byte
+	if details != nil {
+		var err error
+		if data, err = json.Marshal(details); err != nil {
+			return err
+		}
+	}
+	_, err := m.PgClient.Exec("INSERT INTO account_audit (user_id, action, ip, details) VALUES ($1, $2, $3, $4)",
+		userID, action, ip, nullJSON(data))
+	return err
+}
+
+func (m *UserManager) UpdateEmail(userID, email string) error {
+	_, err := m.PgClient.Exec("UPDATE users SET email = $1 WHERE id = $2 AND deleted_at IS NULL", email, userID)
+	return err
+}
+
+func anonymizedEmail(userID string) string {
+	return "deleted+" + userID + "@deleted.invalid"
+}
+
+// SoftDelete anonymizes the user's row and removes their Mongo document, cart
+// included. Both are kept in account_deletions until restoreUntil, so Restore
+// can undo it.
+func (m *UserManager) SoftDelete(userID, email string, restoreUntil time.Time) error {
+	ctx := context.Background()
+	var doc bson.M
+	err := m.MongoClient.FindOne(ctx, bson.M{"user_id": userID}).Decode(&doc)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	var snapshot []byte
+	if doc != nil {
+		delete(doc, "_id")
+		if snapshot, err = bson.MarshalExtJSON(doc, true, false); err != nil {
+			return err
+		}
+	}
+
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`INSERT INTO account_deletions (user_id, email, user_data, deleted_at, restore_until)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET email = $2, user_data = $3, deleted_at = $4, restore_until = $5, purged_at = NULL`,
+		userID, email, nullJSON(snapshot), time.Now(), restoreUntil)
+	if err != nil {
+		return err
+	}
+	res, err := tx.Exec("UPDATE users SET email = $1, deleted_at = $2 WHERE id = $3 AND deleted_at IS NULL",
+		anonymizedEmail(userID), time.Now(), userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	// The Mongo document goes last: if deleting it fails, the transaction
+	// rolls back and the account is left as it was.
+	if _, err := m.MongoClient.DeleteOne(ctx, bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DeletedAccount is an account that can still be restored.
+type DeletedAccount struct {
+	UserID       string
+	Email        string
+	Password     string
+	RestoreUntil time.Time
+}
+
+func (m *UserManager) DeletedByEmail(email string) (*DeletedAccount, error) {
+	var account DeletedAccount
+	err := m.PgClient.QueryRow(`SELECT d.user_id, d.email, u.password, d.restore_until
+		FROM account_deletions d JOIN users u ON u.id = d.user_id
+		WHERE d.email = $1 AND d.purged_at IS NULL AND u.deleted_at IS NOT NULL AND d.restore_until > $2`,
+		email, time.Now()).Scan(&account.UserID, &account.Email, &account.Password, &account.RestoreUntil)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNothingToRestore
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// Restore undoes SoftDelete. It fails if the email was taken by a new account
+// in the meantime.
+func (m *UserManager) Restore(account *DeletedAccount) error {
+	ctx := context.Background()
+	var snapshot sql.NullString
+	err := m.PgClient.QueryRow("SELECT user_data FROM account_deletions WHERE user_id = $1", account.UserID).Scan(&snapshot)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE users SET email = $1, deleted_at = NULL WHERE id = $2", account.Email, account.UserID); err != nil {
+		return fmt.Errorf("email is no longer available: %s", err.Error())
+	}
+	if _, err := tx.Exec("DELETE FROM account_deletions WHERE user_id = $1", account.UserID); err != nil {
+		return err
+	}
+
+	if snapshot.Valid {
+		var doc bson.M
+		if err := bson.UnmarshalExtJSON([]byte(snapshot.String), true, &doc); err != nil {
+			return err
+		}
+		if _, err := m.MongoClient.InsertOne(ctx, doc); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// PurgeDeletions makes the deletions whose grace period is over final: the
+// original email, the Mongo snapshot and the password hash are dropped. It
+// returns the ids of the purged users.
+func (m *UserManager) PurgeDeletions() ([]string, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`UPDATE account_deletions SET email = NULL, user_data = NULL, purged_at = $1
+		WHERE purged_at IS NULL AND restore_until <= $1 RETURNING user_id`, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if _, err := tx.Exec("UPDATE users SET password = '' WHERE id = $1", id); err != nil {
+			return nil, err
+		}
+	}
+	return ids, tx.Commit()
+}
+
+func nullJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// ExportProfile is the user's row without the password hash and TOTP secret.
+func (m *UserManager) ExportProfile(userID string) (map[string]interface{}, error) {
+	var (
+		id, email, role, locale string
+		confirmed, totpEnabled  bool
+		createdAt, confirmedAt  sql.NullTime
+	)
+	err := m.PgClient.QueryRow(`SELECT id, email, role, locale, is_confirmed, totp_enabled, created_at, confirmed_at
+		FROM users WHERE id = $1 AND deleted_at IS NULL`, userID).
+		Scan(&id, &email, &role, &locale, &confirmed, &totpEnabled, &createdAt, &confirmedAt)
+	if err != nil {
+		return nil, err
+	}
+	profile := map[string]interface{}{
+		"id":           id,
+		"email":        email,
+		"role":         role,
+		"locale":       locale,
+		"is_confirmed": confirmed,
+		"totp_enabled": totpEnabled,
+		"created_at":   nil,
+		"confirmed_at": nil,
+	}
+	if createdAt.Valid {
+		profile["created_at"] = createdAt.Time
+	}
+	if confirmedAt.Valid {
+		profile["confirmed_at"] = confirmedAt.Time
+	}
+	return profile, nil
+}
+
+// ExportDocument returns the user's Mongo document, cart included, as JSON.
+func (m *UserManager) ExportDocument(userID string) (json.RawMessage, error) {
+	var doc bson.M
+	err := m.MongoClient.FindOne(context.Background(), bson.M{"user_id": userID}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return json.RawMessage("null"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	delete(doc, "_id")
+	data, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+type AuditEntry struct {
+	Action    string          `json:"action"`
+	IP        string          `json:"ip,omitempty"`
+	Details   json.RawMessage `json:"details,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (m *UserManager) AuditLog(userID string) ([]AuditEntry, error) {
+	rows, err := m.PgClient.Query("SELECT action, COALESCE(ip, ''), details, created_at FROM account_audit WHERE user_id = $1 ORDER BY created_at", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	entries := []AuditEntry{}
+	for rows.Next() {
+		var entry AuditEntry
+		var details sql.NullString
+		if err := rows.Scan(&entry.Action, &entry.IP, &details, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		if details.Valid {
+			entry.Details = json.RawMessage(details.String)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,67 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Ban is why and until when a user is banned.
+type Ban struct {
+	Reason    string     `json:"reason"`
+	StartsAt  time.Time  `json:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ActiveBan returns the user's ban, or nil if they have none on record. Users
+// banned before bans were recorded have none.
+func (m *UserManager) ActiveBan(userID string) (*Ban, error) {
+	var ban Ban
+	err := m.PgClient.QueryRow(`SELECT reason, starts_at, expires_at FROM user_bans
+		WHERE user_id = $1 AND lifted_at IS NULL`, userID).Scan(&ban.Reason, &ban.StartsAt, &ban.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ban, nil
+}
+
+// ExpireBans lifts the bans that have run out, gives their users back the role
+// they had before and returns those users' IDs.
+func (m *UserManager) ExpireBans() ([]string, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`UPDATE user_bans SET lifted_at = $1, lift_reason = 'expired'
+		WHERE lifted_at IS NULL AND expires_at <= $1 RETURNING user_id, previous_role`, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	roles := map[string]string{}
+	for rows.Next() {
+		var id, role string
+		if err := rows.Scan(&id, &role); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		roles[id] = role
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(roles))
+	for id, role := range roles {
+		if _, err := tx.Exec("UPDATE users SET role = $1 WHERE id = $2 AND role = 'banned'", role, id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, tx.Commit()
+}
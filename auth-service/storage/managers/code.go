@@ -0,0 +1,104 @@
+package managers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Purpose is the flow a verification code was issued for. A code can only be
+// redeemed by the flow it was issued for.
+type Purpose string
+
+const (
+	PurposeRegistration  Purpose = "registration"
+	PurposePasswordReset Purpose = "password_reset"
+	PurposeEmailChange   Purpose = "email_change"
+)
+
+var (
+	ErrCodeNotFound = errors.New("verification code expired or was never sent")
+	ErrCodeMismatch = errors.New("incorrect verification code")
+)
+
+// CodeManager stores verification codes in Redis under
+// "code:<purpose>:<email>". Only an HMAC of the code is stored, so a dump of
+// Redis doesn't give the codes away, and a code is deleted when it is redeemed.
+type CodeManager struct {
+	Redis  *redis.Client
+	secret []byte
+	ttls   map[Purpose]time.Duration
+}
+
+func NewCodeManager(rdb *redis.Client, secret string, ttls map[Purpose]time.Duration) *CodeManager {
+	return &CodeManager{Redis: rdb, secret: []byte(secret), ttls: ttls}
+}
+
+func CodeKey(purpose Purpose, email string) string {
+	return "code:" + string(purpose) + ":" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func (m *CodeManager) TTL(purpose Purpose) time.Duration {
+	return m.ttls[purpose]
+}
+
+// hash binds the code to its purpose and email, so the same digits hash
+// differently in every flow.
+func (m *CodeManager) hash(purpose Purpose, email, code string) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(CodeKey(purpose, email) + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Issue stores code for the flow, replacing any earlier code of the same
+// flow. data is handed back by Redeem, for flows that need to remember
+// something about the request, like the new address of an email change.
+func (m *CodeManager) Issue(purpose Purpose, email, code, data string) error {
+	ctx := context.Background()
+	key := CodeKey(purpose, email)
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "hash", m.hash(purpose, email, code), "data", data)
+		pipe.Expire(ctx, key, m.TTL(purpose))
+		return nil
+	})
+	return err
+}
+
+// redeemScript deletes the code if the presented hash matches, so a code can
+// be used only once even by concurrent requests.
+var redeemScript = redis.NewScript(`
+local stored = redis.call("HGET", KEYS[1], "hash")
+if not stored then
+	return {0, ""}
+end
+if stored ~= ARGV[1] then
+	return {-1, ""}
+end
+local data = redis.call("HGET", KEYS[1], "data") or ""
+redis.call("DEL", KEYS[1])
+return {1, data}
+`)
+
+// Redeem consumes the code and returns the data it was issued with.
+func (m *CodeManager) Redeem(purpose Purpose, email, code string) (string, error) {
+	res, err := redeemScript.Run(context.Background(), m.Redis,
+		[]string{CodeKey(purpose, email)}, m.hash(purpose, email, code)).Slice()
+	if err != nil {
+		return "", err
+	}
+	switch res[0].(int64) {
+	case 0:
+		return "", ErrCodeNotFound
+	case -1:
+		return "", ErrCodeMismatch
+	}
+	data, _ := res[1].(string)
+	return data, nil
+}
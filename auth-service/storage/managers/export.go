@@ -0,0 +1,149 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	ExportPending = "pending"
+	ExportRunning = "running"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+)
+
+var ErrExportNotFound = errors.New("export not found or expired")
+
+// ExportJob is a data export requested by a user. Jobs live in Redis under
+// "export:<id>" for as long as their files are kept, "user_export:<uid>"
+// points at the user's latest one and "exports_unfinished" holds the ids of
+// jobs that are pending or running.
+type ExportJob struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"-"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+}
+
+type ExportManager struct {
+	Redis *redis.Client
+	TTL   time.Duration
+}
+
+func NewExportManager(rdb *redis.Client, ttl time.Duration) *ExportManager {
+	return &ExportManager{Redis: rdb, TTL: ttl}
+}
+
+func exportKey(id string) string {
+	return "export:" + id
+}
+
+func userExportKey(userID string) string {
+	return "user_export:" + userID
+}
+
+const unfinishedExportsKey = "exports_unfinished"
+
+func (m *ExportManager) Create(job *ExportJob) error {
+	ctx := context.Background()
+	job.Status = ExportPending
+	job.CreatedAt = time.Now()
+	job.ExpiresAt = job.CreatedAt.Add(m.TTL)
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, exportKey(job.ID),
+			"user_id", job.UserID,
+			"status", job.Status,
+			"created_at", job.CreatedAt.Unix(),
+			"expires_at", job.ExpiresAt.Unix())
+		pipe.ExpireAt(ctx, exportKey(job.ID), job.ExpiresAt)
+		pipe.Set(ctx, userExportKey(job.UserID), job.ID, m.TTL)
+		pipe.SAdd(ctx, unfinishedExportsKey, job.ID)
+		return nil
+	})
+	return err
+}
+
+func (m *ExportManager) Get(id string) (*ExportJob, error) {
+	values, err := m.Redis.HGetAll(context.Background(), exportKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, ErrExportNotFound
+	}
+	unix := func(field string) time.Time {
+		sec, _ := strconv.ParseInt(values[field], 10, 64)
+		return time.Unix(sec, 0)
+	}
+	job := &ExportJob{
+		ID:        id,
+		UserID:    values["user_id"],
+		Status:    values["status"],
+		Error:     values["error"],
+		CreatedAt: unix("created_at"),
+		ExpiresAt: unix("expires_at"),
+	}
+	if values["completed_at"] != "" {
+		completed := unix("completed_at")
+		job.CompletedAt = &completed
+	}
+	return job, nil
+}
+
+// Latest returns the user's most recent export, if it hasn't expired.
+func (m *ExportManager) Latest(userID string) (*ExportJob, error) {
+	id, err := m.Redis.Get(context.Background(), userExportKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrExportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.Get(id)
+}
+
+// Unfinished returns the jobs that are still pending or running.
+func (m *ExportManager) Unfinished() ([]*ExportJob, error) {
+	ctx := context.Background()
+	ids, err := m.Redis.SMembers(ctx, unfinishedExportsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	jobs := []*ExportJob{}
+	for _, id := range ids {
+		job, err := m.Get(id)
+		if errors.Is(err, ErrExportNotFound) {
+			m.Redis.SRem(ctx, unfinishedExportsKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (m *ExportManager) SetStatus(id, status, errMsg string) error {
+	ctx := context.Background()
+	values := []interface{}{"status", status, "error", errMsg}
+	done := status == ExportReady || status == ExportFailed
+	if done {
+		values = append(values, "completed_at", time.Now().Unix())
+	}
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, exportKey(id), values...)
+		if done {
+			pipe.SRem(ctx, unfinishedExportsKey, id)
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,154 @@
+package managers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// LimitPolicy holds the brute-force settings. Every action is limited per IP
+// and per email over a sliding Window; every failed login or code check of an
+// email counts towards a lockout that starts at LockoutBase once
+// LockoutThreshold failures are reached and doubles with each failure after
+// that, up to LockoutMax.
+type LimitPolicy struct {
+	PerIP            int
+	PerEmail         int
+	Window           time.Duration
+	LockoutThreshold int
+	LockoutBase      time.Duration
+	LockoutMax       time.Duration
+	CodeAttempts     int
+}
+
+// LimitManager keeps rate limit windows, failure counters and lockouts in
+// Redis, so the limits hold across every auth-service instance.
+type LimitManager struct {
+	Redis  *redis.Client
+	Policy LimitPolicy
+}
+
+func NewLimitManager(rdb *redis.Client, policy LimitPolicy) *LimitManager {
+	return &LimitManager{Redis: rdb, Policy: policy}
+}
+
+// slidingWindowScript keeps one sorted set member per request, scored by its
+// time in milliseconds. It returns 0 if the request fits in the window, and
+// otherwise how many milliseconds remain until the oldest request leaves it.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+if redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[3]) then
+	local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+	return tonumber(oldest[2]) + window - now
+end
+redis.call("ZADD", KEYS[1], now, ARGV[4])
+redis.call("PEXPIRE", KEYS[1], window)
+return 0
+`)
+
+// Allow counts a request towards the action's limits for ip and email. If
+// either limit is used up, it returns how long the caller has to wait.
+func (m *LimitManager) Allow(action, ip, email string) (time.Duration, error) {
+	if wait, err := m.allow("rate:"+action+":ip:"+ip, m.Policy.PerIP); err != nil || wait > 0 {
+		return wait, err
+	}
+	if email == "" {
+		return 0, nil
+	}
+	return m.allow("rate:"+action+":email:"+email, m.Policy.PerEmail)
+}
+
+func (m *LimitManager) allow(key string, limit int) (time.Duration, error) {
+	now := time.Now().UnixMilli()
+	wait, err := slidingWindowScript.Run(context.Background(), m.Redis, []string{key},
+		now, m.Policy.Window.Milliseconds(), limit, strconv.FormatInt(now, 10)+":"+uuid.NewString()).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+func failuresKey(email string) string {
+	return "auth_failures:" + email
+}
+
+func lockoutKey(email string) string {
+	return "lockout:" + email
+}
+
+// LockedFor returns how much longer the account is locked, or 0.
+func (m *LimitManager) LockedFor(email string) (time.Duration, error) {
+	ttl, err := m.Redis.PTTL(context.Background(), lockoutKey(email)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Fail records a failed attempt for the account and returns the lockout it
+// caused, if any. Failures are forgotten once LockoutMax passes without one.
+func (m *LimitManager) Fail(email string) (time.Duration, error) {
+	ctx := context.Background()
+	failures, err := m.Redis.Incr(ctx, failuresKey(email)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if err := m.Redis.Expire(ctx, failuresKey(email), m.Policy.LockoutMax).Err(); err != nil {
+		return 0, err
+	}
+	over := int(failures) - m.Policy.LockoutThreshold
+	if over < 0 {
+		return 0, nil
+	}
+
+	lockout := m.Policy.LockoutMax
+	if over < 32 && m.Policy.LockoutBase<<over < m.Policy.LockoutMax {
+		lockout = m.Policy.LockoutBase << over
+	}
+	return lockout, m.Redis.Set(ctx, lockoutKey(email), failures, lockout).Err()
+}
+
+// Succeed clears the account's failures after a successful attempt.
+func (m *LimitManager) Succeed(email string) error {
+	return m.Redis.Del(context.Background(), failuresKey(email), lockoutKey(email)).Err()
+}
+
+func codeAttemptsKey(purpose Purpose, email string) string {
+	return "code_attempts:" + string(purpose) + ":" + email
+}
+
+// WrongCode counts a wrong guess of the email's code for purpose. Once
+// CodeAttempts guesses were wrong the code is deleted, so the remaining
+// guesses can't be spent on it; it reports whether that happened.
+func (m *LimitManager) WrongCode(purpose Purpose, email string) (bool, error) {
+	ctx := context.Background()
+	codeKey, attemptsKey := CodeKey(purpose, email), codeAttemptsKey(purpose, email)
+	attempts, err := m.Redis.Incr(ctx, attemptsKey).Result()
+	if err != nil {
+		return false, err
+	}
+	ttl, err := m.Redis.PTTL(ctx, codeKey).Result()
+	if err != nil {
+		return false, err
+	}
+	if ttl > 0 {
+		m.Redis.PExpire(ctx, attemptsKey, ttl)
+	}
+	if int(attempts) < m.Policy.CodeAttempts {
+		return false, nil
+	}
+	return true, m.Redis.Del(ctx, codeKey, attemptsKey).Err()
+}
+
+// ResetCode forgets the wrong guesses, for when a new code is sent.
+func (m *LimitManager) ResetCode(purpose Purpose, email string) error {
+	return m.Redis.Del(context.Background(), codeAttemptsKey(purpose, email)).Err()
+}
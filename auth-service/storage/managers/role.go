@@ -0,0 +1,47 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// Permissions auth-service checks itself. The gateways check the rest.
+const (
+	PermissionUserRead = "user:read"
+)
+
+// Permissions returns what the role is allowed to do. Unknown roles have no
+// permissions.
+func (m *UserManager) Permissions(role string) ([]string, error) {
+	rows, err := m.PgClient.Query("SELECT permission FROM role_permissions WHERE role = $1 ORDER BY permission", role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	permissions := []string{}
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, rows.Err()
+}
+
+func (m *UserManager) HasPermission(role, permission string) (bool, error) {
+	var has bool
+	err := m.PgClient.QueryRow("SELECT EXISTS (SELECT 1 FROM role_permissions WHERE role = $1 AND permission = $2)", role, permission).Scan(&has)
+	return has, err
+}
+
+// TwoFactorRequired reports whether users with the role can't log in without
+// a second factor.
+func (m *UserManager) TwoFactorRequired(role string) (bool, error) {
+	var required bool
+	err := m.PgClient.QueryRow("SELECT two_factor_required FROM roles WHERE name = $1", role).Scan(&required)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return required, err
+}
@@ -0,0 +1,118 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found or revoked")
+	ErrRefreshReused   = errors.New("refresh token was already used, the session is revoked")
+)
+
+// SessionManager keeps login sessions in Redis. A session lives under
+// "session:<sid>" and remembers the jti of the only refresh token that may
+// still be exchanged; every session of a user is listed in
+// "user_sessions:<user_id>". Gateways treat a token whose session key is gone
+// as revoked.
+type SessionManager struct {
+	Redis *redis.Client
+}
+
+func NewSessionManager(rdb *redis.Client) *SessionManager {
+	return &SessionManager{Redis: rdb}
+}
+
+func SessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func userSessionsKey(userID string) string {
+	return "user_sessions:" + userID
+}
+
+func (m *SessionManager) Create(sessionID, userID, refreshID string, ttl time.Duration) error {
+	ctx := context.Background()
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, SessionKey(sessionID), "user_id", userID, "refresh_jti", refreshID)
+		pipe.Expire(ctx, SessionKey(sessionID), ttl)
+		pipe.SAdd(ctx, userSessionsKey(userID), sessionID)
+		pipe.Expire(ctx, userSessionsKey(userID), ttl)
+		return nil
+	})
+	return err
+}
+
+func (m *SessionManager) Exists(sessionID string) (bool, error) {
+	n, err := m.Redis.Exists(context.Background(), SessionKey(sessionID)).Result()
+	return n > 0, err
+}
+
+// rotateScript swaps the session's refresh jti only if the presented one is
+// still current. It returns 1 on success, 0 if the session is gone and -1 if
+// an older refresh token was presented.
+var rotateScript = redis.NewScript(`
+local current = redis.call("HGET", KEYS[1], "refresh_jti")
+if not current then
+	return 0
+end
+if current ~= ARGV[1] then
+	return -1
+end
+redis.call("HSET", KEYS[1], "refresh_jti", ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+// Rotate replaces refreshID with newRefreshID. Presenting a refresh token that
+// was already exchanged means it leaked, so the whole session is revoked.
+func (m *SessionManager) Rotate(sessionID, userID, refreshID, newRefreshID string, ttl time.Duration) error {
+	res, err := rotateScript.Run(context.Background(), m.Redis,
+		[]string{SessionKey(sessionID)}, refreshID, newRefreshID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case 0:
+		return ErrSessionNotFound
+	case -1:
+		if err := m.Revoke(sessionID, userID); err != nil {
+			return err
+		}
+		return ErrRefreshReused
+	}
+	return m.Redis.Expire(context.Background(), userSessionsKey(userID), ttl).Err()
+}
+
+func (m *SessionManager) Revoke(sessionID, userID string) error {
+	ctx := context.Background()
+	_, err := m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, SessionKey(sessionID))
+		pipe.SRem(ctx, userSessionsKey(userID), sessionID)
+		return nil
+	})
+	return err
+}
+
+// RevokeAll ends every session of the user, except the one in keep if given.
+func (m *SessionManager) RevokeAll(userID, keep string) error {
+	ctx := context.Background()
+	sessionIDs, err := m.Redis.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = m.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, sid := range sessionIDs {
+			if sid == keep {
+				continue
+			}
+			pipe.Del(ctx, SessionKey(sid))
+			pipe.SRem(ctx, userSessionsKey(userID), sid)
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,210 @@
+package managers
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrTwoFactorEnabled    = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+	ErrNoPendingSecret     = errors.New("no two-factor enrollment in progress, enroll first")
+)
+
+// TwoFactorManager keeps TOTP secrets and recovery codes in Postgres. Secrets
+// are sealed with AES-GCM and recovery codes are stored as HMACs, both keyed
+// by the TOTP key, so a database dump alone doesn't give the second factor
+// away. Redis remembers the TOTP steps already used.
+type TwoFactorManager struct {
+	PgClient *sql.DB
+	Redis    *redis.Client
+	key      []byte
+}
+
+func NewTwoFactorManager(db *sql.DB, rdb *redis.Client, key string) *TwoFactorManager {
+	sum := sha256.Sum256([]byte(key))
+	return &TwoFactorManager{PgClient: db, Redis: rdb, key: sum[:]}
+}
+
+type TwoFactorState struct {
+	Secret  string // empty if the user never enrolled
+	Enabled bool
+}
+
+func (m *TwoFactorManager) State(userID string) (*TwoFactorState, error) {
+	var sealed sql.NullString
+	state := &TwoFactorState{}
+	err := m.PgClient.QueryRow("SELECT totp_secret, totp_enabled FROM users WHERE id = $1 AND deleted_at IS NULL", userID).Scan(&sealed, &state.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	if sealed.Valid && sealed.String != "" {
+		if state.Secret, err = m.open(sealed.String); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// SetPending stores a new secret that is not used for login until Enable.
+func (m *TwoFactorManager) SetPending(userID, secret string) error {
+	sealed, err := m.seal(secret)
+	if err != nil {
+		return err
+	}
+	res, err := m.PgClient.Exec("UPDATE users SET totp_secret = $1 WHERE id = $2 AND NOT totp_enabled", sealed, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrTwoFactorEnabled
+	}
+	return nil
+}
+
+// Enable turns on the pending secret and replaces the recovery codes.
+func (m *TwoFactorManager) Enable(userID string, recoveryCodes []string) error {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("UPDATE users SET totp_enabled = TRUE WHERE id = $1 AND totp_secret IS NOT NULL AND NOT totp_enabled", userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNoPendingSecret
+	}
+	if err := m.insertRecoveryCodes(tx, userID, recoveryCodes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *TwoFactorManager) Disable(userID string) error {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE users SET totp_secret = NULL, totp_enabled = FALSE WHERE id = $1", userID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ReplaceRecoveryCodes invalidates the user's recovery codes in favour of new ones.
+func (m *TwoFactorManager) ReplaceRecoveryCodes(userID string, recoveryCodes []string) error {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := m.insertRecoveryCodes(tx, userID, recoveryCodes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *TwoFactorManager) insertRecoveryCodes(tx *sql.Tx, userID string, codes []string) error {
+	if _, err := tx.Exec("DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if _, err := tx.Exec("INSERT INTO recovery_codes (user_id, code_hash) VALUES ($1, $2)", userID, m.hashRecoveryCode(code)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UseRecoveryCode spends one of the user's recovery codes. It reports false if
+// the code is wrong or was already used.
+func (m *TwoFactorManager) UseRecoveryCode(userID, code string) (bool, error) {
+	res, err := m.PgClient.Exec("UPDATE recovery_codes SET used_at = $1 WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL",
+		time.Now(), userID, m.hashRecoveryCode(code))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// UseStep marks a TOTP step as used by the user, so a code seen by someone
+// else can't be replayed while it is still valid. It reports false if the step
+// was already used.
+func (m *TwoFactorManager) UseStep(userID string, step uint64, ttl time.Duration) (bool, error) {
+	key := "totp_used:" + userID + ":" + strconv.FormatUint(step, 10)
+	return m.Redis.SetNX(context.Background(), key, 1, ttl).Result()
+}
+
+// UseChallenge marks a challenge token as spent. It reports false if it
+// already was.
+func (m *TwoFactorManager) UseChallenge(jti string, ttl time.Duration) (bool, error) {
+	return m.Redis.SetNX(context.Background(), "challenge_used:"+jti, 1, ttl).Result()
+}
+
+func (m *TwoFactorManager) hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	mac := hmac.New(sha256.New, m.key)
+	mac.Write([]byte(normalized))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (m *TwoFactorManager) seal(secret string) (string, error) {
+	gcm, err := m.gcm()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+func (m *TwoFactorManager) open(sealed string) (string, error) {
+	gcm, err := m.gcm()
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("sealed TOTP secret is too short")
+	}
+	secret, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+func (m *TwoFactorManager) gcm() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(m.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -23,13 +23,13 @@ func NewUserManager(db *sql.DB, client *mongo.Client, dbName, collectionName str
 }
 
 func (m *UserManager) Register(req models.RegisterReq, mongoUser *models.MongoUser) error {
-	query := "INSERT INTO users (id, email, password, role) VALUES ($1, $2, $3, $4)"
+	query := "INSERT INTO users (id, email, password, role, locale) VALUES ($1, $2, $3, $4, $5)"
 	tx, err := m.PgClient.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction in PostgreSQL: %s", err.Error())
 	}
 
-	_, err = tx.Exec(query, req.ID, req.Email, req.Password, req.Role)
+	_, err = tx.Exec(query, req.ID, req.Email, req.Password, req.Role, req.Locale)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to register user in PostgreSQL: %s", err.Error())
@@ -51,16 +51,18 @@ func (m *UserManager) Register(req models.RegisterReq, mongoUser *models.MongoUs
 func (m *UserManager) ConfirmUser(req *models.ConfirmUserReq) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	query := "UPDATE users SET is_confirmed = true, confirmed_at = $1 WHERE email = $2"
+	query := "UPDATE users SET is_confirmed = true, confirmed_at = $1 WHERE email = $2 AND deleted_at IS NULL"
 	_, err := m.PgClient.ExecContext(ctx, query, time.Now(), req.Email)
 	return err
 }
 
+// Profile looks up an account by email for login, recovery and profile
+// reads. Deleted accounts are left out.
 func (m *UserManager) Profile(req models.GetProfileReq) (*models.GetProfileResp, error) {
-	query := "SELECT id, email, password, role, is_confirmed FROM users WHERE email = $1"
+	query := "SELECT id, email, password, role, is_confirmed, locale FROM users WHERE email = $1 AND deleted_at IS NULL"
 	row := m.PgClient.QueryRow(query, req.Email)
 	var user models.GetProfileResp
-	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.Role, &user.IsConfirmed)
+	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.Role, &user.IsConfirmed, &user.Locale)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +74,7 @@ func (m *UserManager) UpdatePassword(req *models.UpdatePasswordReq) error {
 	if err != nil {
 		return err
 	}
-	query := "UPDATE users SET password = $1 WHERE email = $2"
+	query := "UPDATE users SET password = $1 WHERE email = $2 AND deleted_at IS NULL"
 	_, err = m.PgClient.Exec(query, string(hashedPassword), req.Email)
 	return err
 }
@@ -91,11 +93,21 @@ func (m *UserManager) IsEmailExists(email string) error {
 }
 
 func (m *UserManager) GetByID(id *models.GetProfileByIdReq) (*models.GetProfileByIdResp, error) {
-	query := "SELECT id, email, role FROM users WHERE id = $1"
+	query := "SELECT id, email, role, is_confirmed, locale, created_at FROM users WHERE id = $1 AND deleted_at IS NULL"
 	user := &models.GetProfileByIdResp{}
-	err := m.PgClient.QueryRow(query, id.ID).Scan(&user.ID, &user, &user.Email, &user.Role)
+	err := m.PgClient.QueryRow(query, id.ID).Scan(&user.ID, &user.Email, &user.Role, &user.IsConfirmed, &user.Locale, &user.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
+
+// Role returns the user's current role, which is "banned" for a banned user.
+func (m *UserManager) Role(id string) (string, error) {
+	var role string
+	err := m.PgClient.QueryRow("SELECT role FROM users WHERE id = $1 AND deleted_at IS NULL", id).Scan(&role)
+	if err != nil {
+		return "", err
+	}
+	return role, nil
+}
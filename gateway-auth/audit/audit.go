@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log"
+
+	"gateway-auth/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Entry is one privileged change. Before and after are snapshots of the
+// target, either of which is nil when there was nothing there.
+type Entry struct {
+	ActorID    string
+	ActorRole  string
+	Source     string
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	IP         string
+	UserAgent  string
+}
+
+// Recorder appends to the audit log that api-gateway-admin serves, marking
+// every entry with the gateway it came through.
+type Recorder struct {
+	PgClient *sql.DB
+	Source   string
+}
+
+func NewRecorder(db *sql.DB, source string) *Recorder {
+	return &Recorder{PgClient: db, Source: source}
+}
+
+// Record logs a privileged change made by the authenticated caller. It runs
+// after the change, which a failure to log can't undo, so it is only logged.
+func (r *Recorder) Record(c *gin.Context, action, targetType, targetID string, before, after interface{}) {
+	entry := &Entry{
+		Source:     r.Source,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     Snapshot(before),
+		After:      Snapshot(after),
+		IP:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+	}
+	entry.ActorID, entry.ActorRole = middleware.Actor(c)
+	if err := r.Insert(entry); err != nil {
+		log.Printf("failed to audit %s of %s %s: %v", action, targetType, targetID, err)
+	}
+}
+
+func (r *Recorder) Insert(entry *Entry) error {
+	_, err := r.PgClient.Exec(`INSERT INTO admin_audit_log
+		(actor_id, actor_role, source, action, target_type, target_id, before, after, ip, user_agent)
+		VALUES (NULLIF($1, '')::uuid, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		entry.ActorID, entry.ActorRole, entry.Source, entry.Action, entry.TargetType, entry.TargetID,
+		nullJSON(entry.Before), nullJSON(entry.After), entry.IP, entry.UserAgent)
+	return err
+}
+
+// Snapshot encodes v for an entry, nil staying nil.
+func Snapshot(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("failed to snapshot %T for the audit log: %v", v, err)
+		return nil
+	}
+	return data
+}
+
+func nullJSON(data []byte) interface{} {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// Kinds of actor order-service allows status changes by. A gateway picks one
+// from the permission it checked, never from the name of the caller's role.
+const (
+	ActorStaff   = "staff"
+	ActorCourier = "courier"
+)
+
+// Actor returns the id and current role of the authenticated caller, or empty
+// strings before JWTMiddleware has run.
+func Actor(c *gin.Context) (id, role string) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		return "", ""
+	}
+	id, _ = claims.(jwt.MapClaims)["user_id"].(string)
+	role, _ = claims.(jwt.MapClaims)["role"].(string)
+	return id, role
+}
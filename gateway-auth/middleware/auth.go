@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"sync"
+	"time"
+
+	pb "gateway-auth/genprotos"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+)
+
+// maxCachedTokens bounds the cache; past it expired entries are dropped, and
+// if none have expired yet the cache starts over.
+const maxCachedTokens = 10000
+
+// AuthClient asks auth-service about access tokens and remembers each answer
+// for ttl, so most requests skip the round trip while a ban or a revoked
+// session still reaches every gateway within ttl.
+type AuthClient struct {
+	client pb.AuthServiceClient
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedToken
+}
+
+type cachedToken struct {
+	info    *pb.TokenInfo
+	expires time.Time
+}
+
+func NewAuthClient(conn *grpc.ClientConn, ttl time.Duration) *AuthClient {
+	return &AuthClient{
+		client: pb.NewAuthServiceClient(conn),
+		ttl:    ttl,
+		cache:  map[[sha256.Size]byte]cachedToken{},
+	}
+}
+
+func (a *AuthClient) Introspect(ctx context.Context, token string) (*pb.TokenInfo, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+
+	a.mu.Lock()
+	cached, ok := a.cache[key]
+	a.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.info, nil
+	}
+
+	info, err := a.client.Introspect(ctx, &pb.TokenReq{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	expires := now.Add(a.ttl)
+	if info.Active && info.ExpiresAt > 0 && time.Unix(info.ExpiresAt, 0).Before(expires) {
+		expires = time.Unix(info.ExpiresAt, 0)
+	}
+	a.mu.Lock()
+	if len(a.cache) >= maxCachedTokens {
+		for k, v := range a.cache {
+			if now.After(v.expires) {
+				delete(a.cache, k)
+			}
+		}
+		if len(a.cache) >= maxCachedTokens {
+			a.cache = map[[sha256.Size]byte]cachedToken{}
+		}
+	}
+	a.cache[key] = cachedToken{info: info, expires: expires}
+	a.mu.Unlock()
+	return info, nil
+}
+
+// JWTMiddleware lets a request through only if auth-service reports its
+// access token as active. The role and permissions in the claims are the
+// user's current ones, not the ones the token was issued with.
+func JWTMiddleware(auth *AuthClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			c.Abort()
+			return
+		}
+
+		info, err := auth.Introspect(c.Request.Context(), authHeader)
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Couldn't check token", "details": err.Error()})
+			c.Abort()
+			return
+		}
+		if info.Banned {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are banned"})
+			c.Abort()
+			return
+		}
+		if !info.Active {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token", "details": info.Reason})
+			c.Abort()
+			return
+		}
+
+		c.Set("claims", jwt.MapClaims{
+			"user_id":     info.UserId,
+			"email":       info.Email,
+			"role":        info.Role,
+			"permissions": info.Permissions,
+			"sid":         info.SessionId,
+			"exp":         float64(info.ExpiresAt),
+		})
+		c.Next()
+	}
+}
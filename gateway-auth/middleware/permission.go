@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// RequirePermission lets a request through only if the user's role has every
+// one of permissions. It must run after JWTMiddleware.
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, exists := c.Get("claims")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			c.Abort()
+			return
+		}
+		granted, _ := claims.(jwt.MapClaims)["permissions"].([]string)
+		for _, permission := range permissions {
+			if !slices.Contains(granted, permission) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "missing_permission": permission})
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cast"
+)
+
+type Config struct {
+	ORDER_SERVICE_PORT   string
+	PRODUCT_SERVICE_PORT string
+
+	STOCK_RETURN_INTERVAL time.Duration
+
+	DB_HOST     string
+	DB_PORT     int
+	DB_USER     string
+	DB_PASSWORD string
+	DB_NAME     string
+}
+
+func Load() Config {
+	if err := godotenv.Load(); err != nil {
+		fmt.Println("No .env file found")
+	}
+
+	config := Config{}
+
+	config.ORDER_SERVICE_PORT = cast.ToString(coalesce("ORDER_SERVICE_PORT", ":50053"))
+	config.PRODUCT_SERVICE_PORT = cast.ToString(coalesce("PRODUCT_SERVICE_PORT", ":50051"))
+
+	config.STOCK_RETURN_INTERVAL = cast.ToDuration(coalesce("STOCK_RETURN_INTERVAL", "1m"))
+
+	config.DB_HOST = cast.ToString(coalesce("DB_HOST", "localhost"))
+	config.DB_PORT = cast.ToInt(coalesce("DB_PORT", 5432))
+	config.DB_USER = cast.ToString(coalesce("DB_USER", "postgres"))
+	config.DB_PASSWORD = cast.ToString(coalesce("DB_PASSWORD", "root"))
+	config.DB_NAME = cast.ToString(coalesce("DB_NAME", "delivery_orders"))
+
+	return config
+}
+
+func coalesce(key string, defaultValue interface{}) interface{} {
+	val, exists := os.LookupEnv(key)
+
+	if exists {
+		return val
+	}
+
+	return defaultValue
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	cf "order-service/config"
+	"order-service/storage"
+
+	pb "order-service/genprotos"
+	service "order-service/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	config := cf.Load()
+	em := cf.NewErrorManager()
+
+	db, err := storage.NewPostgresStorage(config)
+	em.CheckErr(err)
+	defer db.PgClient.Close()
+
+	productConn, err := grpc.NewClient(fmt.Sprintf("localhost%s", config.PRODUCT_SERVICE_PORT), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	em.CheckErr(err)
+	defer productConn.Close()
+
+	listener, err := net.Listen("tcp", config.ORDER_SERVICE_PORT)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	orderService := service.NewOrderService(db, pb.NewProductServiceClient(productConn))
+	go orderService.ReturnStock(config.STOCK_RETURN_INTERVAL)
+
+	s := grpc.NewServer()
+	pb.RegisterOrderServiceServer(s, orderService)
+
+	log.Printf("server listening at %v", listener.Addr())
+	if err := s.Serve(listener); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
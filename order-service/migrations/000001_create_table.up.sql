@@ -0,0 +1,28 @@
+CREATE TABLE orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    courier_id UUID,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending',
+    address TEXT NOT NULL,
+    total_price NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    cancel_reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_orders_user_id ON orders (user_id);
+CREATE INDEX idx_orders_courier_id ON orders (courier_id);
+
+-- Line items keep a snapshot of the product as it was at purchase time,
+-- so later catalog edits don't rewrite order history.
+CREATE TABLE order_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    product_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    weight REAL NOT NULL DEFAULT 0,
+    price NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    quantity BIGINT NOT NULL CHECK (quantity > 0)
+);
+
+CREATE INDEX idx_order_items_order_id ON order_items (order_id);
@@ -0,0 +1,11 @@
+ALTER TABLE orders ADD COLUMN cancel_reason TEXT;
+
+UPDATE orders o SET cancel_reason = h.reason
+FROM order_status_history h
+WHERE h.order_id = o.id AND h.to_status = 'cancelled';
+
+DROP TRIGGER IF EXISTS order_status_history_no_update ON order_status_history;
+DROP FUNCTION IF EXISTS order_status_history_append_only();
+DROP TABLE IF EXISTS order_status_history;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
@@ -0,0 +1,38 @@
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (status IN (
+    'pending', 'confirmed', 'preparing', 'ready_for_pickup',
+    'picked_up', 'delivered', 'cancelled', 'failed'
+));
+
+CREATE TABLE order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    from_status VARCHAR(32),
+    to_status VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(64) NOT NULL DEFAULT '',
+    actor_role VARCHAR(32) NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_order_status_history_order_id ON order_status_history (order_id, id);
+
+-- History is append-only: every row is a fact about something that already happened.
+CREATE FUNCTION order_status_history_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'order_status_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER order_status_history_no_update
+    BEFORE UPDATE OR DELETE ON order_status_history
+    FOR EACH ROW EXECUTE FUNCTION order_status_history_append_only();
+
+-- Carry over what we know about existing orders before dropping cancel_reason.
+INSERT INTO order_status_history (order_id, from_status, to_status, actor_id, actor_role, created_at)
+SELECT id, NULL, 'pending', user_id::text, 'user', created_at FROM orders;
+
+INSERT INTO order_status_history (order_id, from_status, to_status, actor_id, actor_role, reason, created_at)
+SELECT id, 'pending', 'cancelled', user_id::text, 'user', COALESCE(cancel_reason, ''), updated_at
+FROM orders WHERE status = 'cancelled';
+
+ALTER TABLE orders DROP COLUMN cancel_reason;
@@ -0,0 +1,2 @@
+-- Orders are priced from the catalog, which now carries a currency per product.
+ALTER TABLE orders ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UZS';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_orders_stock_to_return;
+ALTER TABLE orders DROP COLUMN stock_returned_at;
+ALTER TABLE orders DROP COLUMN reservation_id;
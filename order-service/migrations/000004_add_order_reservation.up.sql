@@ -0,0 +1,6 @@
+-- Cancelled and failed orders give their stock back to product-service.
+ALTER TABLE orders ADD COLUMN reservation_id VARCHAR(24);
+ALTER TABLE orders ADD COLUMN stock_returned_at TIMESTAMP;
+
+CREATE INDEX idx_orders_stock_to_return ON orders (id)
+    WHERE reservation_id IS NOT NULL AND stock_returned_at IS NULL AND status IN ('cancelled', 'failed');
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	pb "order-service/genprotos"
+	"order-service/storage"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrderService struct {
+	storage storage.StorageI
+	product pb.ProductServiceClient
+	pb.UnimplementedOrderServiceServer
+}
+
+func NewOrderService(storage storage.StorageI, product pb.ProductServiceClient) *OrderService {
+	return &OrderService{storage: storage, product: product}
+}
+
+// CreateOrder snapshots every line item from product-service, so the order
+// keeps the name, weight and price the customer actually bought. Prices are
+// always taken from the catalog, never from the client.
+func (s *OrderService) CreateOrder(ctx context.Context, req *pb.OrderCReq) (*pb.OrderGRes, error) {
+	if uuid.Validate(req.UserId) != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order must contain at least one item")
+	}
+
+	order := &pb.OrderGRes{
+		UserId:  req.UserId,
+		Status:  StatusPending,
+		Address: req.Address,
+	}
+	for _, item := range req.Items {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity for product %s", item.ProductId)
+		}
+		product, err := s.product.Get(ctx, &pb.ByID{Id: item.ProductId})
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "product %s: %s", item.ProductId, status.Convert(err).Message())
+		}
+		if product.Currency == "" || product.Price <= 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "product %s has no price", item.ProductId)
+		}
+		if order.Currency == "" {
+			order.Currency = product.Currency
+		} else if order.Currency != product.Currency {
+			return nil, status.Error(codes.InvalidArgument, "all products of an order must be priced in the same currency")
+		}
+		order.Items = append(order.Items, &pb.OrderItem{
+			ProductId: item.ProductId,
+			Name:      product.Name,
+			Weight:    product.Weight,
+			Price:     product.EffectivePrice,
+			Quantity:  item.Quantity,
+		})
+		order.TotalPrice += product.EffectivePrice * float64(item.Quantity)
+	}
+
+	order.TotalPrice = math.Round(order.TotalPrice*100) / 100
+
+	return s.storage.Order().Create(order, req.ReservationId, req.UserRole)
+}
+
+func (s *OrderService) GetOrder(ctx context.Context, req *pb.ByID) (*pb.OrderGRes, error) {
+	return s.storage.Order().Get(req)
+}
+
+func (s *OrderService) ListOrders(ctx context.Context, req *pb.OrderGAReq) (*pb.OrderGARes, error) {
+	return s.storage.Order().GetAll(req)
+}
+
+// CancelOrder lets a customer cancel their own order while the state machine still allows it.
+func (s *OrderService) CancelOrder(ctx context.Context, req *pb.OrderCancelReq) (*pb.Void, error) {
+	_, err := s.UpdateStatus(ctx, &pb.OrderStatusUReq{
+		OrderId:   req.Id,
+		Status:    StatusCancelled,
+		ActorId:   req.UserId,
+		ActorRole: req.UserRole,
+		ActorKind: ActorCustomer,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Void{}, nil
+}
+
+func (s *OrderService) UpdateStatus(ctx context.Context, req *pb.OrderStatusUReq) (*pb.OrderGRes, error) {
+	if req.ActorId == "" || req.ActorKind == "" {
+		return nil, status.Error(codes.InvalidArgument, "actor id and kind are required")
+	}
+	order, err := s.storage.Order().Get(&pb.ByID{Id: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.ActorKind {
+	case ActorCustomer:
+		if order.UserId != req.ActorId {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+	case ActorCourier:
+		assignable := order.CourierId == "" && req.Status == StatusPickedUp
+		if order.CourierId != req.ActorId && !assignable {
+			return nil, status.Error(codes.PermissionDenied, "order is assigned to another courier")
+		}
+	}
+
+	if err := checkTransition(order.Status, req.Status, req.ActorKind); err != nil {
+		return nil, err
+	}
+	res, err := s.storage.Order().UpdateStatus(req, order.Status)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status == StatusCancelled || req.Status == StatusFailed {
+		// The order has already moved, so ReturnStock retries a failure later.
+		if err := s.returnStock(ctx, req.OrderId); err != nil {
+			log.Printf("failed to return stock of order %s: %v", req.OrderId, err)
+		}
+	}
+	return res, nil
+}
+
+// ReturnStock periodically gives back the stock of cancelled and failed orders
+// that couldn't be returned right away.
+func (s *OrderService) ReturnStock(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ids, err := s.storage.Order().OrdersWithStockToReturn()
+		if err != nil {
+			log.Printf("failed to list orders with stock to return: %v", err)
+			continue
+		}
+		for _, id := range ids {
+			if err := s.returnStock(context.Background(), id); err != nil {
+				log.Printf("failed to return stock of order %s: %v", id, err)
+			}
+		}
+	}
+}
+
+// returnStock gives the order's stock back to product-service, which ignores
+// a reservation returned twice.
+func (s *OrderService) returnStock(ctx context.Context, orderID string) error {
+	reservationID, err := s.storage.Order().StockToReturn(orderID)
+	if err != nil || reservationID == "" {
+		return err
+	}
+	if _, err := s.product.ReturnStock(ctx, &pb.ReservationReq{ReservationId: reservationID}); err != nil {
+		return err
+	}
+	return s.storage.Order().MarkStockReturned(orderID)
+}
+
+func (s *OrderService) GetHistory(ctx context.Context, req *pb.ByID) (*pb.OrderHistoryRes, error) {
+	return s.storage.Order().GetHistory(req)
+}
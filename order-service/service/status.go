@@ -0,0 +1,72 @@
+package service
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	StatusPending        = "pending"
+	StatusConfirmed      = "confirmed"
+	StatusPreparing      = "preparing"
+	StatusReadyForPickup = "ready_for_pickup"
+	StatusPickedUp       = "picked_up"
+	StatusDelivered      = "delivered"
+	StatusCancelled      = "cancelled"
+	StatusFailed         = "failed"
+)
+
+// Actor kinds, set by the gateways from the permission they checked, so that
+// the transitions don't depend on how roles are named.
+const (
+	ActorStaff    = "staff"
+	ActorCourier  = "courier"
+	ActorCustomer = "customer"
+)
+
+// transitions lists every legal status change and the actor kinds allowed to
+// make it.
+var transitions = map[string]map[string][]string{
+	StatusPending: {
+		StatusConfirmed: {ActorStaff},
+		StatusCancelled: {ActorCustomer, ActorStaff},
+	},
+	StatusConfirmed: {
+		StatusPreparing: {ActorStaff},
+		StatusCancelled: {ActorCustomer, ActorStaff},
+	},
+	StatusPreparing: {
+		StatusReadyForPickup: {ActorStaff},
+		StatusCancelled:      {ActorStaff},
+		StatusFailed:         {ActorStaff},
+	},
+	StatusReadyForPickup: {
+		StatusPickedUp: {ActorCourier},
+		StatusFailed:   {ActorStaff, ActorCourier},
+	},
+	StatusPickedUp: {
+		StatusDelivered: {ActorCourier},
+		StatusFailed:    {ActorStaff, ActorCourier},
+	},
+	StatusDelivered: {},
+	StatusCancelled: {},
+	StatusFailed:    {},
+}
+
+// checkTransition reports whether an actor of the kind may move an order from
+// one status to another.
+func checkTransition(from, to, kind string) error {
+	if _, ok := transitions[to]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown order status %q", to)
+	}
+	kinds, ok := transitions[from][to]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "order can't move from %q to %q", from, to)
+	}
+	for _, k := range kinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s can't move an order from %q to %q", kind, from, to)
+}
@@ -0,0 +1,296 @@
+package managers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "order-service/genprotos"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrderManager struct {
+	PgClient *sql.DB
+}
+
+func NewOrderManager(db *sql.DB) *OrderManager {
+	return &OrderManager{PgClient: db}
+}
+
+func (m *OrderManager) Create(order *pb.OrderGRes, reservationID, userRole string) (*pb.OrderGRes, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	order.Id = uuid.NewString()
+	var createdAt, updatedAt time.Time
+	query := `INSERT INTO orders (id, user_id, status, address, total_price, currency, reservation_id)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')) RETURNING created_at, updated_at`
+	err = tx.QueryRow(query, order.Id, order.UserId, order.Status, order.Address, order.TotalPrice, order.Currency, reservationID).Scan(&createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %s", err.Error())
+	}
+
+	itemQuery := `INSERT INTO order_items (order_id, product_id, name, weight, price, quantity)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+	for _, item := range order.Items {
+		_, err = tx.Exec(itemQuery, order.Id, item.ProductId, item.Name, item.Weight, item.Price, item.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create order item: %s", err.Error())
+		}
+	}
+
+	if err := insertHistory(tx, order.Id, "", order.Status, order.UserId, userRole, ""); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit order: %s", err.Error())
+	}
+	order.CreatedAt = createdAt.Format(time.RFC3339)
+	order.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return order, nil
+}
+
+func (m *OrderManager) Get(req *pb.ByID) (*pb.OrderGRes, error) {
+	if uuid.Validate(req.Id) != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order id")
+	}
+	query := `SELECT id, user_id, COALESCE(courier_id::text, ''), status, address, total_price, currency, created_at, updated_at
+		FROM orders WHERE id = $1`
+	order, err := scanOrder(m.PgClient.QueryRow(query, req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := m.attachItems([]*pb.OrderGRes{order}); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (m *OrderManager) GetAll(req *pb.OrderGAReq) (*pb.OrderGARes, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if req.UserId != "" {
+		args = append(args, req.UserId)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if req.CourierId != "" {
+		args = append(args, req.CourierId)
+		conditions = append(conditions, fmt.Sprintf("courier_id = $%d", len(args)))
+	}
+	if req.Status != "" {
+		args = append(args, req.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := `SELECT id, user_id, COALESCE(courier_id::text, ''), status, address, total_price, currency, created_at, updated_at
+		FROM orders`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+	if req.Pagination != nil && req.Pagination.Limit > 0 {
+		args = append(args, req.Pagination.Limit, req.Pagination.Offset)
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	rows, err := m.PgClient.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := []*pb.OrderGRes{}
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := m.attachItems(orders); err != nil {
+		return nil, err
+	}
+	return &pb.OrderGARes{Orders: orders}, nil
+}
+
+// UpdateStatus moves an order to req.Status only if it is still in status from,
+// and records the change in the order's history within the same transaction.
+func (m *OrderManager) UpdateStatus(req *pb.OrderStatusUReq, from string) (*pb.OrderGRes, error) {
+	tx, err := m.PgClient.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	// A courier picking up an unassigned order becomes its courier.
+	courierID := ""
+	if req.ActorKind == "courier" && req.Status == "picked_up" {
+		courierID = req.ActorId
+	}
+	query := `UPDATE orders SET status = $1, courier_id = COALESCE(courier_id, NULLIF($4, '')::uuid),
+		updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND status = $3`
+	res, err := tx.Exec(query, req.Status, req.OrderId, from, courierID)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.Aborted, "order status was changed concurrently, please retry")
+	}
+
+	if err := insertHistory(tx, req.OrderId, from, req.Status, req.ActorId, req.ActorRole, req.Reason); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit order status: %s", err.Error())
+	}
+	return m.Get(&pb.ByID{Id: req.OrderId})
+}
+
+func (m *OrderManager) GetHistory(req *pb.ByID) (*pb.OrderHistoryRes, error) {
+	if uuid.Validate(req.Id) != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order id")
+	}
+	query := `SELECT COALESCE(from_status, ''), to_status, actor_id, actor_role, reason, created_at
+		FROM order_status_history WHERE order_id = $1 ORDER BY id`
+	rows, err := m.PgClient.Query(query, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &pb.OrderHistoryRes{OrderId: req.Id, Events: []*pb.OrderStatusEvent{}}
+	for rows.Next() {
+		var (
+			event     pb.OrderStatusEvent
+			createdAt time.Time
+		)
+		if err := rows.Scan(&event.FromStatus, &event.ToStatus, &event.ActorId, &event.ActorRole, &event.Reason, &createdAt); err != nil {
+			return nil, err
+		}
+		event.CreatedAt = createdAt.Format(time.RFC3339)
+		res.Events = append(res.Events, &event)
+	}
+	return res, rows.Err()
+}
+
+// stockToReturn matches cancelled and failed orders whose stock hasn't been
+// given back yet.
+const stockToReturn = `reservation_id IS NOT NULL AND stock_returned_at IS NULL AND status IN ('cancelled', 'failed')`
+
+// StockToReturn returns the reservation whose stock the order still has to give
+// back, or "" if there is none.
+func (m *OrderManager) StockToReturn(orderID string) (string, error) {
+	var reservationID string
+	err := m.PgClient.QueryRow("SELECT reservation_id FROM orders WHERE id = $1 AND "+stockToReturn, orderID).Scan(&reservationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return reservationID, err
+}
+
+func (m *OrderManager) OrdersWithStockToReturn() ([]string, error) {
+	rows, err := m.PgClient.Query("SELECT id FROM orders WHERE " + stockToReturn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (m *OrderManager) MarkStockReturned(orderID string) error {
+	_, err := m.PgClient.Exec("UPDATE orders SET stock_returned_at = CURRENT_TIMESTAMP WHERE id = $1", orderID)
+	return err
+}
+
+func insertHistory(tx *sql.Tx, orderID, from, to, actorID, actorRole, reason string) error {
+	query := `INSERT INTO order_status_history (order_id, from_status, to_status, actor_id, actor_role, reason)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)`
+	_, err := tx.Exec(query, orderID, from, to, actorID, actorRole, reason)
+	if err != nil {
+		return fmt.Errorf("failed to record order status history: %s", err.Error())
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanOrder(row rowScanner) (*pb.OrderGRes, error) {
+	var (
+		order                pb.OrderGRes
+		createdAt, updatedAt time.Time
+	)
+	err := row.Scan(&order.Id, &order.UserId, &order.CourierId, &order.Status, &order.Address, &order.TotalPrice, &order.Currency, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	order.CreatedAt = createdAt.Format(time.RFC3339)
+	order.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return &order, nil
+}
+
+func (m *OrderManager) attachItems(orders []*pb.OrderGRes) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	byID := make(map[string]*pb.OrderGRes, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byID[order.Id] = order
+		ids = append(ids, order.Id)
+	}
+
+	query := `SELECT order_id, product_id, name, weight, price, quantity
+		FROM order_items WHERE order_id = ANY($1::uuid[])`
+	rows, err := m.PgClient.Query(query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderID string
+			item    pb.OrderItem
+		)
+		if err := rows.Scan(&orderID, &item.ProductId, &item.Name, &item.Weight, &item.Price, &item.Quantity); err != nil {
+			return err
+		}
+		if order, ok := byID[orderID]; ok {
+			order.Items = append(order.Items, &item)
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"order-service/config"
+	"order-service/storage/managers"
+
+	_ "github.com/lib/pq"
+)
+
+type Storage struct {
+	PgClient *sql.DB
+
+	OrderS OrderI
+}
+
+func NewPostgresStorage(config config.Config) (*Storage, error) {
+	conn := fmt.Sprintf("host=%s user=%s dbname=%s password=%s port=%d sslmode=disable",
+		config.DB_HOST, config.DB_USER, config.DB_NAME, config.DB_PASSWORD, config.DB_PORT)
+	db, err := sql.Open("postgres", conn)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+	fmt.Println("Successfully connected to the database pgsql!!!")
+
+	return &Storage{
+		PgClient: db,
+		OrderS:   managers.NewOrderManager(db),
+	}, nil
+}
+
+func (s *Storage) Order() OrderI {
+	if s.OrderS == nil {
+		s.OrderS = managers.NewOrderManager(s.PgClient)
+	}
+	return s.OrderS
+}
@@ -0,0 +1,20 @@
+package storage
+
+import (
+	pb "order-service/genprotos"
+)
+
+type StorageI interface {
+	Order() OrderI
+}
+
+type OrderI interface {
+	Create(order *pb.OrderGRes, reservationID, userRole string) (*pb.OrderGRes, error)
+	Get(*pb.ByID) (*pb.OrderGRes, error)
+	GetAll(*pb.OrderGAReq) (*pb.OrderGARes, error)
+	UpdateStatus(req *pb.OrderStatusUReq, from string) (*pb.OrderGRes, error)
+	GetHistory(*pb.ByID) (*pb.OrderHistoryRes, error)
+	StockToReturn(orderID string) (string, error)
+	OrdersWithStockToReturn() ([]string, error)
+	MarkStockReturned(orderID string) error
+}
@@ -75,13 +75,15 @@ func (x *ProductRatingUReq) GetRate() float32 {
 	return 0
 }
 
+// Changes stock by delta instead of setting it, so it can't overwrite units
+// reserved in the meantime.
 type ProductCountUReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ProductId string  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Count     float32 `protobuf:"fixed32,2,opt,name=count,proto3" json:"count,omitempty"`
+	Delta     float32 `protobuf:"fixed32,3,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
 func (x *ProductCountUReq) Reset() {
@@ -123,13 +125,84 @@ func (x *ProductCountUReq) GetProductId() string {
 	return ""
 }
 
-func (x *ProductCountUReq) GetCount() float32 {
+func (x *ProductCountUReq) GetDelta() float32 {
 	if x != nil {
-		return x.Count
+		return x.Delta
+	}
+	return 0
+}
+
+type Discount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percent  float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount   float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartsAt string  `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   string  `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Discount) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Discount) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
+func (x *Discount) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Discount) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
 type ProductCReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,12 +216,15 @@ type ProductCReq struct {
 	Weight            float32           `protobuf:"fixed32,6,opt,name=weight,proto3" json:"weight,omitempty"`
 	Seller            string            `protobuf:"bytes,7,opt,name=seller,proto3" json:"seller,omitempty"`
 	AdditionalDetails map[string]string `protobuf:"bytes,8,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Price             float64           `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	Currency          string            `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount          *Discount         `protobuf:"bytes,11,opt,name=discount,proto3" json:"discount,omitempty"`
 }
 
 func (x *ProductCReq) Reset() {
 	*x = ProductCReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[2]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -161,7 +237,7 @@ func (x *ProductCReq) String() string {
 func (*ProductCReq) ProtoMessage() {}
 
 func (x *ProductCReq) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[2]
+	mi := &file_food_delivery_protos_product_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +250,7 @@ func (x *ProductCReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductCReq.ProtoReflect.Descriptor instead.
 func (*ProductCReq) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{2}
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ProductCReq) GetName() string {
@@ -233,6 +309,27 @@ func (x *ProductCReq) GetAdditionalDetails() map[string]string {
 	return nil
 }
 
+func (x *ProductCReq) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductCReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ProductCReq) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
 type ProductCReqForSwagger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -245,12 +342,15 @@ type ProductCReqForSwagger struct {
 	Weight            float32           `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"`
 	Seller            string            `protobuf:"bytes,6,opt,name=seller,proto3" json:"seller,omitempty"`
 	AdditionalDetails map[string]string `protobuf:"bytes,7,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Price             float64           `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Currency          string            `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount          *Discount         `protobuf:"bytes,10,opt,name=discount,proto3" json:"discount,omitempty"`
 }
 
 func (x *ProductCReqForSwagger) Reset() {
 	*x = ProductCReqForSwagger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[3]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -263,7 +363,7 @@ func (x *ProductCReqForSwagger) String() string {
 func (*ProductCReqForSwagger) ProtoMessage() {}
 
 func (x *ProductCReqForSwagger) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[3]
+	mi := &file_food_delivery_protos_product_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +376,7 @@ func (x *ProductCReqForSwagger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductCReqForSwagger.ProtoReflect.Descriptor instead.
 func (*ProductCReqForSwagger) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{3}
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ProductCReqForSwagger) GetName() string {
@@ -328,37 +428,55 @@ func (x *ProductCReqForSwagger) GetAdditionalDetails() map[string]string {
 	return nil
 }
 
-type ProductUReq struct {
+func (x *ProductCReqForSwagger) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductCReqForSwagger) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ProductCReqForSwagger) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type ProductPriceUReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category          string            `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Description       string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Weight            float32           `protobuf:"fixed32,8,opt,name=weight,proto3" json:"weight,omitempty"`
-	Seller            string            `protobuf:"bytes,10,opt,name=seller,proto3" json:"seller,omitempty"`
-	AdditionalDetails map[string]string `protobuf:"bytes,11,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ProductId string    `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price     float64   `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Currency  string    `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount  *Discount `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
 }
 
-func (x *ProductUReq) Reset() {
-	*x = ProductUReq{}
+func (x *ProductPriceUReq) Reset() {
+	*x = ProductPriceUReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[4]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductUReq) String() string {
+func (x *ProductPriceUReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductUReq) ProtoMessage() {}
+func (*ProductPriceUReq) ProtoMessage() {}
 
-func (x *ProductUReq) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[4]
+func (x *ProductPriceUReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,94 +487,65 @@ func (x *ProductUReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductUReq.ProtoReflect.Descriptor instead.
-func (*ProductUReq) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ProductUReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ProductUReq) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ProductUReq) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+// Deprecated: Use ProductPriceUReq.ProtoReflect.Descriptor instead.
+func (*ProductPriceUReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ProductUReq) GetDescription() string {
+func (x *ProductPriceUReq) GetProductId() string {
 	if x != nil {
-		return x.Description
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ProductUReq) GetWeight() float32 {
+func (x *ProductPriceUReq) GetPrice() float64 {
 	if x != nil {
-		return x.Weight
+		return x.Price
 	}
 	return 0
 }
 
-func (x *ProductUReq) GetSeller() string {
+func (x *ProductPriceUReq) GetCurrency() string {
 	if x != nil {
-		return x.Seller
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ProductUReq) GetAdditionalDetails() map[string]string {
+func (x *ProductPriceUReq) GetDiscount() *Discount {
 	if x != nil {
-		return x.AdditionalDetails
+		return x.Discount
 	}
 	return nil
 }
 
-type ProductGRes struct {
+type PriceHistoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category          string            `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Count             float64           `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
-	Description       string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ImgUrl            string            `protobuf:"bytes,6,opt,name=img_url,json=imgUrl,proto3" json:"img_url,omitempty"`
-	Weight            float32           `protobuf:"fixed32,8,opt,name=weight,proto3" json:"weight,omitempty"`
-	Rating            float32           `protobuf:"fixed32,9,opt,name=rating,proto3" json:"rating,omitempty"`
-	Seller            string            `protobuf:"bytes,10,opt,name=seller,proto3" json:"seller,omitempty"`
-	AdditionalDetails map[string]string `protobuf:"bytes,11,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	At        string `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
 }
 
-func (x *ProductGRes) Reset() {
-	*x = ProductGRes{}
+func (x *PriceHistoryReq) Reset() {
+	*x = PriceHistoryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[5]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductGRes) String() string {
+func (x *PriceHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductGRes) ProtoMessage() {}
+func (*PriceHistoryReq) ProtoMessage() {}
 
-func (x *ProductGRes) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[5]
+func (x *PriceHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,110 +556,122 @@ func (x *ProductGRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductGRes.ProtoReflect.Descriptor instead.
-func (*ProductGRes) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PriceHistoryReq.ProtoReflect.Descriptor instead.
+func (*PriceHistoryReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ProductGRes) GetId() string {
+func (x *PriceHistoryReq) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ProductGRes) GetName() string {
+func (x *PriceHistoryReq) GetAt() string {
 	if x != nil {
-		return x.Name
+		return x.At
 	}
 	return ""
 }
 
-func (x *ProductGRes) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+type PriceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price     float64   `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Currency  string    `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount  *Discount `protobuf:"bytes,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	CreatedAt string    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *ProductGRes) GetCount() float64 {
-	if x != nil {
-		return x.Count
+func (x *PriceEntry) Reset() {
+	*x = PriceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ProductGRes) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *PriceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProductGRes) GetImgUrl() string {
-	if x != nil {
-		return x.ImgUrl
+func (*PriceEntry) ProtoMessage() {}
+
+func (x *PriceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductGRes) GetWeight() float32 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
+// Deprecated: Use PriceEntry.ProtoReflect.Descriptor instead.
+func (*PriceEntry) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ProductGRes) GetRating() float32 {
+func (x *PriceEntry) GetPrice() float64 {
 	if x != nil {
-		return x.Rating
+		return x.Price
 	}
 	return 0
 }
 
-func (x *ProductGRes) GetSeller() string {
+func (x *PriceEntry) GetCurrency() string {
 	if x != nil {
-		return x.Seller
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ProductGRes) GetAdditionalDetails() map[string]string {
+func (x *PriceEntry) GetDiscount() *Discount {
 	if x != nil {
-		return x.AdditionalDetails
+		return x.Discount
 	}
 	return nil
 }
 
-type ProductGAReq struct {
+func (x *PriceEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PriceHistoryRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Category   string      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Count      string      `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
-	Rating     string      `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	Seller     string      `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
-	Pagination *Pagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId string        `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Entries   []*PriceEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *ProductGAReq) Reset() {
-	*x = ProductGAReq{}
+func (x *PriceHistoryRes) Reset() {
+	*x = PriceHistoryRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[6]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductGAReq) String() string {
+func (x *PriceHistoryRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductGAReq) ProtoMessage() {}
+func (*PriceHistoryRes) ProtoMessage() {}
 
-func (x *ProductGAReq) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[6]
+func (x *PriceHistoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,71 +682,866 @@ func (x *ProductGAReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductGAReq.ProtoReflect.Descriptor instead.
-func (*ProductGAReq) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceHistoryRes.ProtoReflect.Descriptor instead.
+func (*PriceHistoryRes) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProductGAReq) GetCategory() string {
+func (x *PriceHistoryRes) GetProductId() string {
 	if x != nil {
-		return x.Category
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ProductGAReq) GetCount() string {
+func (x *PriceHistoryRes) GetEntries() []*PriceEntry {
 	if x != nil {
-		return x.Count
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *ProductGAReq) GetRating() string {
-	if x != nil {
-		return x.Rating
-	}
-	return ""
-}
+type ProductUReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ProductGAReq) GetSeller() string {
-	if x != nil {
+	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category          string            `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description       string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Weight            float32           `protobuf:"fixed32,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Seller            string            `protobuf:"bytes,10,opt,name=seller,proto3" json:"seller,omitempty"`
+	AdditionalDetails map[string]string `protobuf:"bytes,11,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProductUReq) Reset() {
+	*x = ProductUReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductUReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductUReq) ProtoMessage() {}
+
+func (x *ProductUReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductUReq.ProtoReflect.Descriptor instead.
+func (*ProductUReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProductUReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductUReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductUReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductUReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductUReq) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProductUReq) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *ProductUReq) GetAdditionalDetails() map[string]string {
+	if x != nil {
+		return x.AdditionalDetails
+	}
+	return nil
+}
+
+type ProductGRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category          string            `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Count             float64           `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+	Description       string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ImgUrl            string            `protobuf:"bytes,6,opt,name=img_url,json=imgUrl,proto3" json:"img_url,omitempty"`
+	Weight            float32           `protobuf:"fixed32,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rating            float32           `protobuf:"fixed32,9,opt,name=rating,proto3" json:"rating,omitempty"`
+	Seller            string            `protobuf:"bytes,10,opt,name=seller,proto3" json:"seller,omitempty"`
+	AdditionalDetails map[string]string `protobuf:"bytes,11,rep,name=additional_details,json=additionalDetails,proto3" json:"additional_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Price             float64           `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
+	Currency          string            `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount          *Discount         `protobuf:"bytes,14,opt,name=discount,proto3" json:"discount,omitempty"`
+	EffectivePrice    float64           `protobuf:"fixed64,15,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+}
+
+func (x *ProductGRes) Reset() {
+	*x = ProductGRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductGRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductGRes) ProtoMessage() {}
+
+func (x *ProductGRes) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductGRes.ProtoReflect.Descriptor instead.
+func (*ProductGRes) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductGRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetCount() float64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ProductGRes) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetImgUrl() string {
+	if x != nil {
+		return x.ImgUrl
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProductGRes) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ProductGRes) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetAdditionalDetails() map[string]string {
+	if x != nil {
+		return x.AdditionalDetails
+	}
+	return nil
+}
+
+func (x *ProductGRes) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductGRes) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ProductGRes) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ProductGRes) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+type ProductGAReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories   []string          `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Sellers      []string          `protobuf:"bytes,4,rep,name=sellers,proto3" json:"sellers,omitempty"`
+	Pagination   *Pagination       `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	MinPrice     *float64          `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice     *float64          `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	MinRating    *float64          `protobuf:"fixed64,8,opt,name=min_rating,json=minRating,proto3,oneof" json:"min_rating,omitempty"`
+	MinWeight    *float64          `protobuf:"fixed64,9,opt,name=min_weight,json=minWeight,proto3,oneof" json:"min_weight,omitempty"`
+	MaxWeight    *float64          `protobuf:"fixed64,10,opt,name=max_weight,json=maxWeight,proto3,oneof" json:"max_weight,omitempty"`
+	InStock      bool              `protobuf:"varint,11,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	Details      map[string]string `protobuf:"bytes,12,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sort         string            `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	Cursor       string            `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeTotal bool              `protobuf:"varint,15,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+}
+
+func (x *ProductGAReq) Reset() {
+	*x = ProductGAReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductGAReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductGAReq) ProtoMessage() {}
+
+func (x *ProductGAReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductGAReq.ProtoReflect.Descriptor instead.
+func (*ProductGAReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProductGAReq) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ProductGAReq) GetSellers() []string {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
+}
+
+func (x *ProductGAReq) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ProductGAReq) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *ProductGAReq) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ProductGAReq) GetMinRating() float64 {
+	if x != nil && x.MinRating != nil {
+		return *x.MinRating
+	}
+	return 0
+}
+
+func (x *ProductGAReq) GetMinWeight() float64 {
+	if x != nil && x.MinWeight != nil {
+		return *x.MinWeight
+	}
+	return 0
+}
+
+func (x *ProductGAReq) GetMaxWeight() float64 {
+	if x != nil && x.MaxWeight != nil {
+		return *x.MaxWeight
+	}
+	return 0
+}
+
+func (x *ProductGAReq) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ProductGAReq) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ProductGAReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ProductGAReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ProductGAReq) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+type ProductGARes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products   []*ProductGRes `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextCursor string         `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore    bool           `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	TotalCount int64          `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ProductGARes) Reset() {
+	*x = ProductGARes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductGARes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductGARes) ProtoMessage() {}
+
+func (x *ProductGARes) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductGARes.ProtoReflect.Descriptor instead.
+func (*ProductGARes) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProductGARes) GetProducts() []*ProductGRes {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ProductGARes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ProductGARes) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ProductGARes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ProductImageUReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImgUrl string `protobuf:"bytes,2,opt,name=img_url,json=imgUrl,proto3" json:"img_url,omitempty"`
+}
+
+func (x *ProductImageUReq) Reset() {
+	*x = ProductImageUReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductImageUReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImageUReq) ProtoMessage() {}
+
+func (x *ProductImageUReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImageUReq.ProtoReflect.Descriptor instead.
+func (*ProductImageUReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProductImageUReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductImageUReq) GetImgUrl() string {
+	if x != nil {
+		return x.ImgUrl
+	}
+	return ""
+}
+
+type StockReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *StockReq) Reset() {
+	*x = StockReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReq) ProtoMessage() {}
+
+func (x *StockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReq.ProtoReflect.Descriptor instead.
+func (*StockReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StockReq) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockReq) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type StockBatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*StockReq `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TtlSeconds int64       `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (x *StockBatchReq) Reset() {
+	*x = StockBatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockBatchReq) ProtoMessage() {}
+
+func (x *StockBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockBatchReq.ProtoReflect.Descriptor instead.
+func (*StockBatchReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StockBatchReq) GetItems() []*StockReq {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockBatchReq) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReservationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+}
+
+func (x *ReservationReq) Reset() {
+	*x = ReservationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationReq) ProtoMessage() {}
+
+func (x *ReservationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationReq.ProtoReflect.Descriptor instead.
+func (*ReservationReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReservationReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReservationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId string      `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Status        string      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Items         []*StockReq `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	ExpiresAt     string      `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ReservationRes) Reset() {
+	*x = ReservationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRes) ProtoMessage() {}
+
+func (x *ReservationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRes.ProtoReflect.Descriptor instead.
+func (*ReservationRes) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReservationRes) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReservationRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReservationRes) GetItems() []*StockReq {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReservationRes) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type SearchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      string      `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Category   string      `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Seller     string      `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchReq) Reset() {
+	*x = SearchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReq) ProtoMessage() {}
+
+func (x *SearchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReq.ProtoReflect.Descriptor instead.
+func (*SearchReq) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchReq) GetSeller() string {
+	if x != nil {
 		return x.Seller
 	}
 	return ""
 }
 
-func (x *ProductGAReq) GetPagination() *Pagination {
+func (x *SearchReq) GetPagination() *Pagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ProductGARes struct {
+type SearchHit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Products []*ProductGRes `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Product    *ProductGRes      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Score      float64           `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights map[string]string `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ProductGARes) Reset() {
-	*x = ProductGARes{}
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[7]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductGARes) String() string {
+func (x *SearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductGARes) ProtoMessage() {}
+func (*SearchHit) ProtoMessage() {}
 
-func (x *ProductGARes) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[7]
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,44 +1552,58 @@ func (x *ProductGARes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductGARes.ProtoReflect.Descriptor instead.
-func (*ProductGARes) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ProductGARes) GetProducts() []*ProductGRes {
+func (x *SearchHit) GetProduct() *ProductGRes {
 	if x != nil {
-		return x.Products
+		return x.Product
 	}
 	return nil
 }
 
-type ProductImageUReq struct {
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type FacetCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ImgUrl string `protobuf:"bytes,2,opt,name=img_url,json=imgUrl,proto3" json:"img_url,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ProductImageUReq) Reset() {
-	*x = ProductImageUReq{}
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_food_delivery_protos_product_proto_msgTypes[8]
+		mi := &file_food_delivery_protos_product_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProductImageUReq) String() string {
+func (x *FacetCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductImageUReq) ProtoMessage() {}
+func (*FacetCount) ProtoMessage() {}
 
-func (x *ProductImageUReq) ProtoReflect() protoreflect.Message {
-	mi := &file_food_delivery_protos_product_proto_msgTypes[8]
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,23 +1614,94 @@ func (x *ProductImageUReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductImageUReq.ProtoReflect.Descriptor instead.
-func (*ProductImageUReq) Descriptor() ([]byte, []int) {
-	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ProductImageUReq) GetId() string {
+func (x *FacetCount) GetValue() string {
 	if x != nil {
-		return x.Id
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ProductImageUReq) GetImgUrl() string {
+func (x *FacetCount) GetCount() int64 {
 	if x != nil {
-		return x.ImgUrl
+		return x.Count
 	}
-	return ""
+	return 0
+}
+
+type SearchRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits       []*SearchHit  `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	Total      int64         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Categories []*FacetCount `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Sellers    []*FacetCount `protobuf:"bytes,4,rep,name=sellers,proto3" json:"sellers,omitempty"`
+}
+
+func (x *SearchRes) Reset() {
+	*x = SearchRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_food_delivery_protos_product_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRes) ProtoMessage() {}
+
+func (x *SearchRes) ProtoReflect() protoreflect.Message {
+	mi := &file_food_delivery_protos_product_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRes.ProtoReflect.Descriptor instead.
+func (*SearchRes) Descriptor() ([]byte, []int) {
+	return file_food_delivery_protos_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchRes) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchRes) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchRes) GetCategories() []*FacetCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchRes) GetSellers() []*FacetCount {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
 }
 
 var File_food_delivery_protos_product_proto protoreflect.FileDescriptor
@@ -735,148 +1716,331 @@ var file_food_delivery_protos_product_proto_rawDesc = []byte{
 	0x55, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
 	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75,
 	0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
 	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xe1, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x67, 0x55, 0x72,
-	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c,
-	0x6c, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x43, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x44,
-	0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xdc, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x43, 0x52, 0x65, 0x71, 0x46, 0x6f, 0x72, 0x53, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52, 0x65, 0x71, 0x46, 0x6f, 0x72, 0x53, 0x77, 0x61, 0x67,
-	0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x44, 0x0a,
-	0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xc2, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x55, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a,
+	0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x22, 0xc3, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x67, 0x55,
+	0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x43, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x03, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x43, 0x52, 0x65, 0x71, 0x46, 0x6f, 0x72, 0x53, 0x77, 0x61, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52, 0x65, 0x71, 0x46, 0x6f, 0x72, 0x53,
+	0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
 	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x47, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x69, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c,
-	0x6c, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x47, 0x52, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x44,
-	0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x47, 0x41, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a,
-	0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x47, 0x52, 0x65, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
-	0x22, 0x3b, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x55, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x32, 0xb5, 0x03,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x37, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x67, 0x12, 0x1a, 0x2e,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40,
+	0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74,
+	0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x60, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x55, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x04, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x47, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47,
+	0x52, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x2e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea,
+	0x04, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41,
+	0x52, 0x65, 0x71, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x9e, 0x01, 0x0a, 0x0c,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x47, 0x52, 0x65, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x10,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x22, 0x45, 0x0a, 0x08, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x5a, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x8b, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6,
+	0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x55, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x52, 0x65,
-	0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52,
-	0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55,
+	0x47, 0x52, 0x65, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x2e, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x68, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x0a, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xb0, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48,
+	0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34,
+	0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x73, 0x32, 0x9f, 0x07, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6d, 0x67, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x12, 0x3b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x39, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55,
 	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x0e, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x0e, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x2c, 0x0a,
-	0x03, 0x47, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
-	0x42, 0x79, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x47, 0x41, 0x52, 0x65, 0x73, 0x42, 0x0c, 0x5a, 0x0a, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x19, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2f, 0x0a,
+	0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x28,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x79, 0x49, 0x44, 0x1a,
+	0x15, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x47, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x12, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x41, 0x52, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x0b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x0c, 0x5a, 0x0a, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -891,53 +2055,99 @@ func file_food_delivery_protos_product_proto_rawDescGZIP() []byte {
 	return file_food_delivery_protos_product_proto_rawDescData
 }
 
-var file_food_delivery_protos_product_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_food_delivery_protos_product_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_food_delivery_protos_product_proto_goTypes = []any{
 	(*ProductRatingUReq)(nil),     // 0: delivery.ProductRatingUReq
 	(*ProductCountUReq)(nil),      // 1: delivery.ProductCountUReq
-	(*ProductCReq)(nil),           // 2: delivery.ProductCReq
-	(*ProductCReqForSwagger)(nil), // 3: delivery.ProductCReqForSwagger
-	(*ProductUReq)(nil),           // 4: delivery.ProductUReq
-	(*ProductGRes)(nil),           // 5: delivery.ProductGRes
-	(*ProductGAReq)(nil),          // 6: delivery.ProductGAReq
-	(*ProductGARes)(nil),          // 7: delivery.ProductGARes
-	(*ProductImageUReq)(nil),      // 8: delivery.ProductImageUReq
-	nil,                           // 9: delivery.ProductCReq.AdditionalDetailsEntry
-	nil,                           // 10: delivery.ProductCReqForSwagger.AdditionalDetailsEntry
-	nil,                           // 11: delivery.ProductUReq.AdditionalDetailsEntry
-	nil,                           // 12: delivery.ProductGRes.AdditionalDetailsEntry
-	(*Pagination)(nil),            // 13: delivery.Pagination
-	(*ByID)(nil),                  // 14: delivery.ByID
-	(*Void)(nil),                  // 15: delivery.Void
+	(*Discount)(nil),              // 2: delivery.Discount
+	(*ProductCReq)(nil),           // 3: delivery.ProductCReq
+	(*ProductCReqForSwagger)(nil), // 4: delivery.ProductCReqForSwagger
+	(*ProductPriceUReq)(nil),      // 5: delivery.ProductPriceUReq
+	(*PriceHistoryReq)(nil),       // 6: delivery.PriceHistoryReq
+	(*PriceEntry)(nil),            // 7: delivery.PriceEntry
+	(*PriceHistoryRes)(nil),       // 8: delivery.PriceHistoryRes
+	(*ProductUReq)(nil),           // 9: delivery.ProductUReq
+	(*ProductGRes)(nil),           // 10: delivery.ProductGRes
+	(*ProductGAReq)(nil),          // 11: delivery.ProductGAReq
+	(*ProductGARes)(nil),          // 12: delivery.ProductGARes
+	(*ProductImageUReq)(nil),      // 13: delivery.ProductImageUReq
+	(*StockReq)(nil),              // 14: delivery.StockReq
+	(*StockBatchReq)(nil),         // 15: delivery.StockBatchReq
+	(*ReservationReq)(nil),        // 16: delivery.ReservationReq
+	(*ReservationRes)(nil),        // 17: delivery.ReservationRes
+	(*SearchReq)(nil),             // 18: delivery.SearchReq
+	(*SearchHit)(nil),             // 19: delivery.SearchHit
+	(*FacetCount)(nil),            // 20: delivery.FacetCount
+	(*SearchRes)(nil),             // 21: delivery.SearchRes
+	nil,                           // 22: delivery.ProductCReq.AdditionalDetailsEntry
+	nil,                           // 23: delivery.ProductCReqForSwagger.AdditionalDetailsEntry
+	nil,                           // 24: delivery.ProductUReq.AdditionalDetailsEntry
+	nil,                           // 25: delivery.ProductGRes.AdditionalDetailsEntry
+	nil,                           // 26: delivery.ProductGAReq.DetailsEntry
+	nil,                           // 27: delivery.SearchHit.HighlightsEntry
+	(*Pagination)(nil),            // 28: delivery.Pagination
+	(*ByID)(nil),                  // 29: delivery.ByID
+	(*Void)(nil),                  // 30: delivery.Void
 }
 var file_food_delivery_protos_product_proto_depIdxs = []int32{
-	9,  // 0: delivery.ProductCReq.additional_details:type_name -> delivery.ProductCReq.AdditionalDetailsEntry
-	10, // 1: delivery.ProductCReqForSwagger.additional_details:type_name -> delivery.ProductCReqForSwagger.AdditionalDetailsEntry
-	11, // 2: delivery.ProductUReq.additional_details:type_name -> delivery.ProductUReq.AdditionalDetailsEntry
-	12, // 3: delivery.ProductGRes.additional_details:type_name -> delivery.ProductGRes.AdditionalDetailsEntry
-	13, // 4: delivery.ProductGAReq.pagination:type_name -> delivery.Pagination
-	5,  // 5: delivery.ProductGARes.products:type_name -> delivery.ProductGRes
-	8,  // 6: delivery.ProductService.UpdateImg:input_type -> delivery.ProductImageUReq
-	0,  // 7: delivery.ProductService.UpdateRating:input_type -> delivery.ProductRatingUReq
-	1,  // 8: delivery.ProductService.UpdateCount:input_type -> delivery.ProductCountUReq
-	2,  // 9: delivery.ProductService.Create:input_type -> delivery.ProductCReq
-	4,  // 10: delivery.ProductService.Update:input_type -> delivery.ProductUReq
-	14, // 11: delivery.ProductService.Delete:input_type -> delivery.ByID
-	14, // 12: delivery.ProductService.Get:input_type -> delivery.ByID
-	6,  // 13: delivery.ProductService.GetAll:input_type -> delivery.ProductGAReq
-	15, // 14: delivery.ProductService.UpdateImg:output_type -> delivery.Void
-	15, // 15: delivery.ProductService.UpdateRating:output_type -> delivery.Void
-	15, // 16: delivery.ProductService.UpdateCount:output_type -> delivery.Void
-	15, // 17: delivery.ProductService.Create:output_type -> delivery.Void
-	15, // 18: delivery.ProductService.Update:output_type -> delivery.Void
-	15, // 19: delivery.ProductService.Delete:output_type -> delivery.Void
-	5,  // 20: delivery.ProductService.Get:output_type -> delivery.ProductGRes
-	7,  // 21: delivery.ProductService.GetAll:output_type -> delivery.ProductGARes
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	22, // 0: delivery.ProductCReq.additional_details:type_name -> delivery.ProductCReq.AdditionalDetailsEntry
+	2,  // 1: delivery.ProductCReq.discount:type_name -> delivery.Discount
+	23, // 2: delivery.ProductCReqForSwagger.additional_details:type_name -> delivery.ProductCReqForSwagger.AdditionalDetailsEntry
+	2,  // 3: delivery.ProductCReqForSwagger.discount:type_name -> delivery.Discount
+	2,  // 4: delivery.ProductPriceUReq.discount:type_name -> delivery.Discount
+	2,  // 5: delivery.PriceEntry.discount:type_name -> delivery.Discount
+	7,  // 6: delivery.PriceHistoryRes.entries:type_name -> delivery.PriceEntry
+	24, // 7: delivery.ProductUReq.additional_details:type_name -> delivery.ProductUReq.AdditionalDetailsEntry
+	25, // 8: delivery.ProductGRes.additional_details:type_name -> delivery.ProductGRes.AdditionalDetailsEntry
+	2,  // 9: delivery.ProductGRes.discount:type_name -> delivery.Discount
+	28, // 10: delivery.ProductGAReq.pagination:type_name -> delivery.Pagination
+	26, // 11: delivery.ProductGAReq.details:type_name -> delivery.ProductGAReq.DetailsEntry
+	10, // 12: delivery.ProductGARes.products:type_name -> delivery.ProductGRes
+	14, // 13: delivery.StockBatchReq.items:type_name -> delivery.StockReq
+	14, // 14: delivery.ReservationRes.items:type_name -> delivery.StockReq
+	28, // 15: delivery.SearchReq.pagination:type_name -> delivery.Pagination
+	10, // 16: delivery.SearchHit.product:type_name -> delivery.ProductGRes
+	27, // 17: delivery.SearchHit.highlights:type_name -> delivery.SearchHit.HighlightsEntry
+	19, // 18: delivery.SearchRes.hits:type_name -> delivery.SearchHit
+	20, // 19: delivery.SearchRes.categories:type_name -> delivery.FacetCount
+	20, // 20: delivery.SearchRes.sellers:type_name -> delivery.FacetCount
+	13, // 21: delivery.ProductService.UpdateImg:input_type -> delivery.ProductImageUReq
+	0,  // 22: delivery.ProductService.UpdateRating:input_type -> delivery.ProductRatingUReq
+	1,  // 23: delivery.ProductService.UpdateCount:input_type -> delivery.ProductCountUReq
+	5,  // 24: delivery.ProductService.UpdatePrice:input_type -> delivery.ProductPriceUReq
+	6,  // 25: delivery.ProductService.GetPriceHistory:input_type -> delivery.PriceHistoryReq
+	3,  // 26: delivery.ProductService.Create:input_type -> delivery.ProductCReq
+	9,  // 27: delivery.ProductService.Update:input_type -> delivery.ProductUReq
+	29, // 28: delivery.ProductService.Delete:input_type -> delivery.ByID
+	29, // 29: delivery.ProductService.Get:input_type -> delivery.ByID
+	11, // 30: delivery.ProductService.GetAll:input_type -> delivery.ProductGAReq
+	18, // 31: delivery.ProductService.Search:input_type -> delivery.SearchReq
+	14, // 32: delivery.ProductService.ReserveStock:input_type -> delivery.StockReq
+	15, // 33: delivery.ProductService.ReserveStockBatch:input_type -> delivery.StockBatchReq
+	16, // 34: delivery.ProductService.ReleaseStock:input_type -> delivery.ReservationReq
+	16, // 35: delivery.ProductService.CommitStock:input_type -> delivery.ReservationReq
+	16, // 36: delivery.ProductService.ReturnStock:input_type -> delivery.ReservationReq
+	30, // 37: delivery.ProductService.UpdateImg:output_type -> delivery.Void
+	30, // 38: delivery.ProductService.UpdateRating:output_type -> delivery.Void
+	30, // 39: delivery.ProductService.UpdateCount:output_type -> delivery.Void
+	30, // 40: delivery.ProductService.UpdatePrice:output_type -> delivery.Void
+	8,  // 41: delivery.ProductService.GetPriceHistory:output_type -> delivery.PriceHistoryRes
+	29, // 42: delivery.ProductService.Create:output_type -> delivery.ByID
+	30, // 43: delivery.ProductService.Update:output_type -> delivery.Void
+	30, // 44: delivery.ProductService.Delete:output_type -> delivery.Void
+	10, // 45: delivery.ProductService.Get:output_type -> delivery.ProductGRes
+	12, // 46: delivery.ProductService.GetAll:output_type -> delivery.ProductGARes
+	21, // 47: delivery.ProductService.Search:output_type -> delivery.SearchRes
+	17, // 48: delivery.ProductService.ReserveStock:output_type -> delivery.ReservationRes
+	17, // 49: delivery.ProductService.ReserveStockBatch:output_type -> delivery.ReservationRes
+	30, // 50: delivery.ProductService.ReleaseStock:output_type -> delivery.Void
+	30, // 51: delivery.ProductService.CommitStock:output_type -> delivery.Void
+	30, // 52: delivery.ProductService.ReturnStock:output_type -> delivery.Void
+	37, // [37:53] is the sub-list for method output_type
+	21, // [21:37] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_food_delivery_protos_product_proto_init() }
@@ -972,7 +2182,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductCReq); i {
+			switch v := v.(*Discount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +2194,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductCReqForSwagger); i {
+			switch v := v.(*ProductCReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -996,7 +2206,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductUReq); i {
+			switch v := v.(*ProductCReqForSwagger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1008,7 +2218,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductGRes); i {
+			switch v := v.(*ProductPriceUReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1020,7 +2230,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductGAReq); i {
+			switch v := v.(*PriceHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1032,7 +2242,7 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductGARes); i {
+			switch v := v.(*PriceEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,6 +2254,66 @@ func file_food_delivery_protos_product_proto_init() {
 			}
 		}
 		file_food_delivery_protos_product_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceHistoryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductUReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductGRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductGAReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductGARes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*ProductImageUReq); i {
 			case 0:
 				return &v.state
@@ -1055,14 +2325,111 @@ func file_food_delivery_protos_product_proto_init() {
 				return nil
 			}
 		}
+		file_food_delivery_protos_product_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*StockReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*StockBatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ReservationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ReservationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_food_delivery_protos_product_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_food_delivery_protos_product_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_food_delivery_protos_product_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
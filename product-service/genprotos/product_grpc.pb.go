@@ -19,14 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ProductService_UpdateImg_FullMethodName    = "/delivery.ProductService/UpdateImg"
-	ProductService_UpdateRating_FullMethodName = "/delivery.ProductService/UpdateRating"
-	ProductService_UpdateCount_FullMethodName  = "/delivery.ProductService/UpdateCount"
-	ProductService_Create_FullMethodName       = "/delivery.ProductService/Create"
-	ProductService_Update_FullMethodName       = "/delivery.ProductService/Update"
-	ProductService_Delete_FullMethodName       = "/delivery.ProductService/Delete"
-	ProductService_Get_FullMethodName          = "/delivery.ProductService/Get"
-	ProductService_GetAll_FullMethodName       = "/delivery.ProductService/GetAll"
+	ProductService_UpdateImg_FullMethodName         = "/delivery.ProductService/UpdateImg"
+	ProductService_UpdateRating_FullMethodName      = "/delivery.ProductService/UpdateRating"
+	ProductService_UpdateCount_FullMethodName       = "/delivery.ProductService/UpdateCount"
+	ProductService_UpdatePrice_FullMethodName       = "/delivery.ProductService/UpdatePrice"
+	ProductService_GetPriceHistory_FullMethodName   = "/delivery.ProductService/GetPriceHistory"
+	ProductService_Create_FullMethodName            = "/delivery.ProductService/Create"
+	ProductService_Update_FullMethodName            = "/delivery.ProductService/Update"
+	ProductService_Delete_FullMethodName            = "/delivery.ProductService/Delete"
+	ProductService_Get_FullMethodName               = "/delivery.ProductService/Get"
+	ProductService_GetAll_FullMethodName            = "/delivery.ProductService/GetAll"
+	ProductService_Search_FullMethodName            = "/delivery.ProductService/Search"
+	ProductService_ReserveStock_FullMethodName      = "/delivery.ProductService/ReserveStock"
+	ProductService_ReserveStockBatch_FullMethodName = "/delivery.ProductService/ReserveStockBatch"
+	ProductService_ReleaseStock_FullMethodName      = "/delivery.ProductService/ReleaseStock"
+	ProductService_CommitStock_FullMethodName       = "/delivery.ProductService/CommitStock"
+	ProductService_ReturnStock_FullMethodName       = "/delivery.ProductService/ReturnStock"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -36,11 +44,21 @@ type ProductServiceClient interface {
 	UpdateImg(ctx context.Context, in *ProductImageUReq, opts ...grpc.CallOption) (*Void, error)
 	UpdateRating(ctx context.Context, in *ProductRatingUReq, opts ...grpc.CallOption) (*Void, error)
 	UpdateCount(ctx context.Context, in *ProductCountUReq, opts ...grpc.CallOption) (*Void, error)
-	Create(ctx context.Context, in *ProductCReq, opts ...grpc.CallOption) (*Void, error)
+	UpdatePrice(ctx context.Context, in *ProductPriceUReq, opts ...grpc.CallOption) (*Void, error)
+	GetPriceHistory(ctx context.Context, in *PriceHistoryReq, opts ...grpc.CallOption) (*PriceHistoryRes, error)
+	Create(ctx context.Context, in *ProductCReq, opts ...grpc.CallOption) (*ByID, error)
 	Update(ctx context.Context, in *ProductUReq, opts ...grpc.CallOption) (*Void, error)
 	Delete(ctx context.Context, in *ByID, opts ...grpc.CallOption) (*Void, error)
 	Get(ctx context.Context, in *ByID, opts ...grpc.CallOption) (*ProductGRes, error)
 	GetAll(ctx context.Context, in *ProductGAReq, opts ...grpc.CallOption) (*ProductGARes, error)
+	Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchRes, error)
+	ReserveStock(ctx context.Context, in *StockReq, opts ...grpc.CallOption) (*ReservationRes, error)
+	ReserveStockBatch(ctx context.Context, in *StockBatchReq, opts ...grpc.CallOption) (*ReservationRes, error)
+	ReleaseStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error)
+	CommitStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error)
+	// ReturnStock gives back whatever stock a reservation still holds, including
+	// the sold stock of a committed one whose order was cancelled or failed.
+	ReturnStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error)
 }
 
 type productServiceClient struct {
@@ -81,9 +99,29 @@ func (c *productServiceClient) UpdateCount(ctx context.Context, in *ProductCount
 	return out, nil
 }
 
-func (c *productServiceClient) Create(ctx context.Context, in *ProductCReq, opts ...grpc.CallOption) (*Void, error) {
+func (c *productServiceClient) UpdatePrice(ctx context.Context, in *ProductPriceUReq, opts ...grpc.CallOption) (*Void, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Void)
+	err := c.cc.Invoke(ctx, ProductService_UpdatePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *PriceHistoryReq, opts ...grpc.CallOption) (*PriceHistoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHistoryRes)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Create(ctx context.Context, in *ProductCReq, opts ...grpc.CallOption) (*ByID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ByID)
 	err := c.cc.Invoke(ctx, ProductService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -131,6 +169,66 @@ func (c *productServiceClient) GetAll(ctx context.Context, in *ProductGAReq, opt
 	return out, nil
 }
 
+func (c *productServiceClient) Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRes)
+	err := c.cc.Invoke(ctx, ProductService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStock(ctx context.Context, in *StockReq, opts ...grpc.CallOption) (*ReservationRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservationRes)
+	err := c.cc.Invoke(ctx, ProductService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStockBatch(ctx context.Context, in *StockBatchReq, opts ...grpc.CallOption) (*ReservationRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservationRes)
+	err := c.cc.Invoke(ctx, ProductService_ReserveStockBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReleaseStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ProductService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CommitStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ProductService_CommitStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReturnStock(ctx context.Context, in *ReservationReq, opts ...grpc.CallOption) (*Void, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ProductService_ReturnStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility
@@ -138,11 +236,21 @@ type ProductServiceServer interface {
 	UpdateImg(context.Context, *ProductImageUReq) (*Void, error)
 	UpdateRating(context.Context, *ProductRatingUReq) (*Void, error)
 	UpdateCount(context.Context, *ProductCountUReq) (*Void, error)
-	Create(context.Context, *ProductCReq) (*Void, error)
+	UpdatePrice(context.Context, *ProductPriceUReq) (*Void, error)
+	GetPriceHistory(context.Context, *PriceHistoryReq) (*PriceHistoryRes, error)
+	Create(context.Context, *ProductCReq) (*ByID, error)
 	Update(context.Context, *ProductUReq) (*Void, error)
 	Delete(context.Context, *ByID) (*Void, error)
 	Get(context.Context, *ByID) (*ProductGRes, error)
 	GetAll(context.Context, *ProductGAReq) (*ProductGARes, error)
+	Search(context.Context, *SearchReq) (*SearchRes, error)
+	ReserveStock(context.Context, *StockReq) (*ReservationRes, error)
+	ReserveStockBatch(context.Context, *StockBatchReq) (*ReservationRes, error)
+	ReleaseStock(context.Context, *ReservationReq) (*Void, error)
+	CommitStock(context.Context, *ReservationReq) (*Void, error)
+	// ReturnStock gives back whatever stock a reservation still holds, including
+	// the sold stock of a committed one whose order was cancelled or failed.
+	ReturnStock(context.Context, *ReservationReq) (*Void, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -159,7 +267,13 @@ func (UnimplementedProductServiceServer) UpdateRating(context.Context, *ProductR
 func (UnimplementedProductServiceServer) UpdateCount(context.Context, *ProductCountUReq) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCount not implemented")
 }
-func (UnimplementedProductServiceServer) Create(context.Context, *ProductCReq) (*Void, error) {
+func (UnimplementedProductServiceServer) UpdatePrice(context.Context, *ProductPriceUReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *PriceHistoryReq) (*PriceHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) Create(context.Context, *ProductCReq) (*ByID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
 func (UnimplementedProductServiceServer) Update(context.Context, *ProductUReq) (*Void, error) {
@@ -174,6 +288,24 @@ func (UnimplementedProductServiceServer) Get(context.Context, *ByID) (*ProductGR
 func (UnimplementedProductServiceServer) GetAll(context.Context, *ProductGAReq) (*ProductGARes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
+func (UnimplementedProductServiceServer) Search(context.Context, *SearchReq) (*SearchRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveStock(context.Context, *StockReq) (*ReservationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveStockBatch(context.Context, *StockBatchReq) (*ReservationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStockBatch not implemented")
+}
+func (UnimplementedProductServiceServer) ReleaseStock(context.Context, *ReservationReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedProductServiceServer) CommitStock(context.Context, *ReservationReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitStock not implemented")
+}
+func (UnimplementedProductServiceServer) ReturnStock(context.Context, *ReservationReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnStock not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +373,42 @@ func _ProductService_UpdateCount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_UpdatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPriceUReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdatePrice(ctx, req.(*ProductPriceUReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, req.(*PriceHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProductCReq)
 	if err := dec(in); err != nil {
@@ -331,6 +499,114 @@ func _ProductService_GetAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Search(ctx, req.(*SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStock(ctx, req.(*StockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveStockBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStockBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveStockBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStockBatch(ctx, req.(*StockBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReleaseStock(ctx, req.(*ReservationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CommitStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CommitStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CommitStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CommitStock(ctx, req.(*ReservationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReturnStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReturnStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReturnStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReturnStock(ctx, req.(*ReservationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,6 +626,14 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCount",
 			Handler:    _ProductService_UpdateCount_Handler,
 		},
+		{
+			MethodName: "UpdatePrice",
+			Handler:    _ProductService_UpdatePrice_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ProductService_GetPriceHistory_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ProductService_Create_Handler,
@@ -370,6 +654,30 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _ProductService_GetAll_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _ProductService_Search_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ProductService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReserveStockBatch",
+			Handler:    _ProductService_ReserveStockBatch_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _ProductService_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "CommitStock",
+			Handler:    _ProductService_CommitStock_Handler,
+		},
+		{
+			MethodName: "ReturnStock",
+			Handler:    _ProductService_ReturnStock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "food-delivery-protos/product.proto",
@@ -26,8 +26,11 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	productService := service.NewProductService(db, config.STOCK_RESERVATION_TTL)
+	go productService.ExpireReservations(config.STOCK_SWEEP_INTERVAL)
+
 	s := grpc.NewServer()
-	pb.RegisterProductServiceServer(s, service.NewProductService(db))
+	pb.RegisterProductServiceServer(s, productService)
 
 	log.Printf("server listening at %v", listener.Addr())
 	if err := s.Serve(listener); err != nil {
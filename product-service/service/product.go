@@ -2,20 +2,23 @@ package service
 
 import (
 	"context"
+	"log"
 	pb "progress-service/genprotos"
 	"progress-service/storage"
+	"time"
 )
 
 type ProductService struct {
-	storage storage.StorageI
+	storage        storage.StorageI
+	reservationTTL time.Duration
 	pb.UnimplementedProductServiceServer
 }
 
-func NewProductService(storage storage.StorageI) *ProductService {
-	return &ProductService{storage: storage}
+func NewProductService(storage storage.StorageI, reservationTTL time.Duration) *ProductService {
+	return &ProductService{storage: storage, reservationTTL: reservationTTL}
 }
 
-func (s *ProductService) Create(ctx context.Context, req *pb.ProductCReq) (*pb.Void, error) {
+func (s *ProductService) Create(ctx context.Context, req *pb.ProductCReq) (*pb.ByID, error) {
 	return s.storage.Product().Create(req)
 }
 
@@ -31,10 +34,14 @@ func (s *ProductService) GetAll(ctx context.Context, req *pb.ProductGAReq) (*pb.
 	return s.storage.Product().GetAll(req)
 }
 
-func (s *ProductService) GetProduct(ctx context.Context, req *pb.ByID) (*pb.ProductGRes, error) {
+func (s *ProductService) Get(ctx context.Context, req *pb.ByID) (*pb.ProductGRes, error) {
 	return s.storage.Product().Get(req)
 }
 
+func (s *ProductService) Search(ctx context.Context, req *pb.SearchReq) (*pb.SearchRes, error) {
+	return s.storage.Product().Search(req)
+}
+
 func (s *ProductService) UpdateRating(ctx context.Context, req *pb.ProductRatingUReq) (*pb.Void, error) {
 	return s.storage.Product().UpdateRating(req)
 }
@@ -42,3 +49,51 @@ func (s *ProductService) UpdateRating(ctx context.Context, req *pb.ProductRating
 func (s *ProductService) UpdateCount(ctx context.Context, req *pb.ProductCountUReq) (*pb.Void, error) {
 	return s.storage.Product().UpdateCount(req)
 }
+
+func (s *ProductService) UpdatePrice(ctx context.Context, req *pb.ProductPriceUReq) (*pb.Void, error) {
+	return s.storage.Product().UpdatePrice(req)
+}
+
+func (s *ProductService) GetPriceHistory(ctx context.Context, req *pb.PriceHistoryReq) (*pb.PriceHistoryRes, error) {
+	return s.storage.Product().GetPriceHistory(req)
+}
+
+func (s *ProductService) ReserveStock(ctx context.Context, req *pb.StockReq) (*pb.ReservationRes, error) {
+	return s.storage.Stock().Reserve([]*pb.StockReq{req}, s.reservationTTL)
+}
+
+func (s *ProductService) ReserveStockBatch(ctx context.Context, req *pb.StockBatchReq) (*pb.ReservationRes, error) {
+	ttl := s.reservationTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	return s.storage.Stock().Reserve(req.Items, ttl)
+}
+
+func (s *ProductService) ReleaseStock(ctx context.Context, req *pb.ReservationReq) (*pb.Void, error) {
+	return s.storage.Stock().Release(req)
+}
+
+func (s *ProductService) CommitStock(ctx context.Context, req *pb.ReservationReq) (*pb.Void, error) {
+	return s.storage.Stock().Commit(req)
+}
+
+func (s *ProductService) ReturnStock(ctx context.Context, req *pb.ReservationReq) (*pb.Void, error) {
+	return s.storage.Stock().Return(req)
+}
+
+// ExpireReservations periodically gives back stock held by abandoned checkouts.
+func (s *ProductService) ExpireReservations(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		n, err := s.storage.Stock().ExpireReservations()
+		if err != nil {
+			log.Printf("failed to expire stock reservations: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("expired %d stock reservations", n)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package managers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultListLimit = 10
+	maxListLimit     = 100
+)
+
+// cursor is the position after the last product of a page. Clients only see
+// it as an opaque string.
+type cursor struct {
+	Sort  string   `json:"s,omitempty"`
+	Value *float64 `json:"v,omitempty"`
+	ID    string   `json:"id"`
+}
+
+func encodeCursor(sort string, p *productDoc) string {
+	c := cursor{Sort: sort, ID: p.ID.Hex()}
+	switch sort {
+	case SortPriceAsc, SortPriceDesc:
+		c.Value = &p.Price
+	case SortRating:
+		rating := float64(p.Rating)
+		c.Value = &rating
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// afterCursor returns the filter that selects products after the encoded
+// cursor in the given sort order. Ties on the sort key are broken by _id,
+// matching productSort.
+func afterCursor(encoded, sort string) (bson.M, error) {
+	invalid := status.Error(codes.InvalidArgument, "invalid cursor")
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, invalid
+	}
+	if c.Sort != sort {
+		return nil, status.Error(codes.InvalidArgument, "cursor was issued for a different sort order")
+	}
+	id, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, invalid
+	}
+
+	keyset := func(field, op string) (bson.M, error) {
+		if c.Value == nil {
+			return nil, invalid
+		}
+		return bson.M{"$or": bson.A{
+			bson.M{field: bson.M{op: *c.Value}},
+			bson.M{field: *c.Value, "_id": bson.M{"$gt": id}},
+		}}, nil
+	}
+	switch sort {
+	case SortPriceAsc:
+		return keyset("price", "$gt")
+	case SortPriceDesc:
+		return keyset("price", "$lt")
+	case SortRating:
+		return keyset("rating", "$lt")
+	case SortNewest:
+		return bson.M{"_id": bson.M{"$lt": id}}, nil
+	default:
+		return bson.M{"_id": bson.M{"$gt": id}}, nil
+	}
+}
+
+// BackfillSortKeys sets price and rating to 0 on products created before
+// those fields existed. Mongo sorts a missing field before every number, while
+// the keyset filters of afterCursor compare numbers only, so without a value
+// such products would be skipped by cursor pages.
+func (m *ProductManager) BackfillSortKeys() error {
+	ctx := context.Background()
+	for _, field := range []string{"price", "rating"} {
+		_, err := m.Collection.UpdateMany(ctx, bson.M{field: nil}, bson.M{"$set": bson.M{field: 0}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listLimit(limit int64) int64 {
+	if limit <= 0 {
+		return defaultListLimit
+	}
+	if limit > maxListLimit {
+		return maxListLimit
+	}
+	return limit
+}
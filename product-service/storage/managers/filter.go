@@ -0,0 +1,91 @@
+package managers
+
+import (
+	"regexp"
+
+	pb "progress-service/genprotos"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortRating    = "rating"
+	SortNewest    = "newest"
+)
+
+// detailKeyPattern keeps additional_details keys from reaching into other
+// fields or being read as operators.
+var detailKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// productFilter turns the typed filters of req into a Mongo filter. Price
+// filters apply to the list price, before any discount.
+func productFilter(req *pb.ProductGAReq) (bson.M, error) {
+	filter := bson.M{}
+	if len(req.Categories) > 0 {
+		filter["category"] = bson.M{"$in": req.Categories}
+	}
+	if len(req.Sellers) > 0 {
+		filter["seller"] = bson.M{"$in": req.Sellers}
+	}
+	if err := addRange(filter, "price", req.MinPrice, req.MaxPrice); err != nil {
+		return nil, err
+	}
+	if err := addRange(filter, "rating", req.MinRating, nil); err != nil {
+		return nil, err
+	}
+	if err := addRange(filter, "weight", req.MinWeight, req.MaxWeight); err != nil {
+		return nil, err
+	}
+	if req.InStock {
+		filter["count"] = bson.M{"$gt": 0}
+	}
+	for key, value := range req.Details {
+		if !detailKeyPattern.MatchString(key) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid additional_details key %q", key)
+		}
+		filter["additional_details."+key] = value
+	}
+	return filter, nil
+}
+
+func addRange(filter bson.M, field string, min, max *float64) error {
+	if min == nil && max == nil {
+		return nil
+	}
+	if min != nil && max != nil && *min > *max {
+		return status.Errorf(codes.InvalidArgument, "min %s is greater than max %s", field, field)
+	}
+	cond := bson.M{}
+	if min != nil {
+		cond["$gte"] = *min
+	}
+	if max != nil {
+		cond["$lte"] = *max
+	}
+	filter[field] = cond
+	return nil
+}
+
+// productSort maps a sort name to a Mongo sort. _id breaks ties so that pages
+// don't overlap when many products share a price or rating.
+func productSort(sort string) (bson.D, error) {
+	switch sort {
+	case "":
+		return bson.D{{Key: "_id", Value: 1}}, nil
+	case SortPriceAsc:
+		return bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}}, nil
+	case SortPriceDesc:
+		return bson.D{{Key: "price", Value: -1}, {Key: "_id", Value: 1}}, nil
+	case SortRating:
+		return bson.D{{Key: "rating", Value: -1}, {Key: "_id", Value: 1}}, nil
+	case SortNewest:
+		return bson.D{{Key: "_id", Value: -1}}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort %q, expected one of %s, %s, %s, %s",
+			sort, SortPriceAsc, SortPriceDesc, SortRating, SortNewest)
+	}
+}
@@ -0,0 +1,213 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "progress-service/genprotos"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultCurrency = "UZS"
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type discountDoc struct {
+	Percent  float64    `bson:"percent,omitempty"`
+	Amount   float64    `bson:"amount,omitempty"`
+	StartsAt *time.Time `bson:"starts_at,omitempty"`
+	EndsAt   *time.Time `bson:"ends_at,omitempty"`
+}
+
+// activeAt reports whether the discount applies at t. Missing bounds are open.
+func (d *discountDoc) activeAt(t time.Time) bool {
+	if d.StartsAt != nil && t.Before(*d.StartsAt) {
+		return false
+	}
+	if d.EndsAt != nil && !t.Before(*d.EndsAt) {
+		return false
+	}
+	return true
+}
+
+func (d *discountDoc) toProto() *pb.Discount {
+	if d == nil {
+		return nil
+	}
+	res := &pb.Discount{Percent: d.Percent, Amount: d.Amount}
+	if d.StartsAt != nil {
+		res.StartsAt = d.StartsAt.Format(time.RFC3339)
+	}
+	if d.EndsAt != nil {
+		res.EndsAt = d.EndsAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+// effectivePrice is the price a customer pays at t, rounded to two decimals.
+func effectivePrice(price float64, discount *discountDoc, t time.Time) float64 {
+	if discount != nil && discount.activeAt(t) {
+		if discount.Percent > 0 {
+			price -= price * discount.Percent / 100
+		} else {
+			price -= discount.Amount
+		}
+	}
+	return math.Max(0, math.Round(price*100)/100)
+}
+
+type priceEntryDoc struct {
+	ProductID primitive.ObjectID `bson:"product_id"`
+	Price     float64            `bson:"price"`
+	Currency  string             `bson:"currency"`
+	Discount  *discountDoc       `bson:"discount,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// parsePrice validates price, currency and discount as sent by a client.
+func parsePrice(price float64, currency string, discount *pb.Discount) (string, *discountDoc, error) {
+	if price <= 0 {
+		return "", nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if !currencyPattern.MatchString(currency) {
+		return "", nil, status.Errorf(codes.InvalidArgument, "invalid currency %q, expected an ISO 4217 code", currency)
+	}
+	if discount == nil || (discount.Percent == 0 && discount.Amount == 0) {
+		return currency, nil, nil
+	}
+
+	d := &discountDoc{Percent: discount.Percent, Amount: discount.Amount}
+	switch {
+	case d.Percent != 0 && d.Amount != 0:
+		return "", nil, status.Error(codes.InvalidArgument, "discount can be either a percent or an amount, not both")
+	case d.Percent < 0 || d.Percent > 100:
+		return "", nil, status.Error(codes.InvalidArgument, "discount percent must be between 0 and 100")
+	case d.Amount < 0 || d.Amount > price:
+		return "", nil, status.Error(codes.InvalidArgument, "discount amount must be between 0 and the price")
+	}
+	if discount.StartsAt != "" {
+		t, err := time.Parse(time.RFC3339, discount.StartsAt)
+		if err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "discount starts_at must be an RFC 3339 time")
+		}
+		d.StartsAt = &t
+	}
+	if discount.EndsAt != "" {
+		t, err := time.Parse(time.RFC3339, discount.EndsAt)
+		if err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "discount ends_at must be an RFC 3339 time")
+		}
+		d.EndsAt = &t
+	}
+	if d.StartsAt != nil && d.EndsAt != nil && !d.EndsAt.After(*d.StartsAt) {
+		return "", nil, status.Error(codes.InvalidArgument, "discount must end after it starts")
+	}
+	return currency, d, nil
+}
+
+func (m *ProductManager) UpdatePrice(req *pb.ProductPriceUReq) (*pb.Void, error) {
+	id, err := primitive.ObjectIDFromHex(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+	currency, discount, err := parsePrice(req.Price, req.Currency, req.Discount)
+	if err != nil {
+		return nil, err
+	}
+	update := bson.M{"$set": bson.M{"price": req.Price, "currency": currency}}
+	if discount != nil {
+		update["$set"].(bson.M)["discount"] = discount
+	} else {
+		update["$unset"] = bson.M{"discount": ""}
+	}
+	res, err := m.Collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err := m.recordPrice(id, req.Price, currency, discount); err != nil {
+		return nil, err
+	}
+	return &pb.Void{}, nil
+}
+
+// GetPriceHistory lists every price a product has had, oldest first. With
+// req.At set it returns only the entry that was in effect at that moment.
+func (m *ProductManager) GetPriceHistory(req *pb.PriceHistoryReq) (*pb.PriceHistoryRes, error) {
+	id, err := primitive.ObjectIDFromHex(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+	res := &pb.PriceHistoryRes{ProductId: req.ProductId, Entries: []*pb.PriceEntry{}}
+	ctx := context.Background()
+
+	if req.At != "" {
+		at, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "at must be an RFC 3339 time")
+		}
+		var entry priceEntryDoc
+		err = m.PriceHistory.FindOne(ctx,
+			bson.M{"product_id": id, "created_at": bson.M{"$lte": at}},
+			options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})).Decode(&entry)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "product had no price at that time")
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Entries = append(res.Entries, entry.toProto())
+		return res, nil
+	}
+
+	cursor, err := m.PriceHistory.Find(ctx, bson.M{"product_id": id},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var entry priceEntryDoc
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+		res.Entries = append(res.Entries, entry.toProto())
+	}
+	return res, cursor.Err()
+}
+
+func (m *ProductManager) recordPrice(id primitive.ObjectID, price float64, currency string, discount *discountDoc) error {
+	_, err := m.PriceHistory.InsertOne(context.Background(), priceEntryDoc{
+		ProductID: id,
+		Price:     price,
+		Currency:  currency,
+		Discount:  discount,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+func (e *priceEntryDoc) toProto() *pb.PriceEntry {
+	return &pb.PriceEntry{
+		Price:     e.Price,
+		Currency:  e.Currency,
+		Discount:  e.Discount.toProto(),
+		CreatedAt: e.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	pb "progress-service/genprotos"
 
@@ -12,35 +13,93 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ProductManager struct {
-	Collection *mongo.Collection
-	PgClient   *sql.DB
+	Collection   *mongo.Collection
+	PriceHistory *mongo.Collection
+	PgClient     *sql.DB
 }
 
-func NewProductManager(client *mongo.Client, dbName, collectionName string, pgClient *sql.DB) *ProductManager {
-	collection := client.Database(dbName).Collection(collectionName)
-	return &ProductManager{Collection: collection, PgClient: pgClient}
+// productDoc mirrors the stored document; pb.ProductGRes has no bson tags,
+// so decoding straight into it loses _id, img_url and additional_details.
+type productDoc struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty"`
+	Name              string             `bson:"name"`
+	Category          string             `bson:"category"`
+	Count             float64            `bson:"count"`
+	Description       string             `bson:"description"`
+	ImgUrl            string             `bson:"img_url"`
+	Weight            float32            `bson:"weight"`
+	Rating            float32            `bson:"rating"`
+	Seller            string             `bson:"seller"`
+	AdditionalDetails map[string]string  `bson:"additional_details"`
+	Price             float64            `bson:"price"`
+	Currency          string             `bson:"currency"`
+	Discount          *discountDoc       `bson:"discount,omitempty"`
 }
 
-func (m *ProductManager) Create(req *pb.ProductCReq) (*pb.Void, error) {
+func (p *productDoc) toProto() *pb.ProductGRes {
+	return &pb.ProductGRes{
+		Id:                p.ID.Hex(),
+		Name:              p.Name,
+		Category:          p.Category,
+		Count:             p.Count,
+		Description:       p.Description,
+		ImgUrl:            p.ImgUrl,
+		Weight:            p.Weight,
+		Rating:            p.Rating,
+		Seller:            p.Seller,
+		AdditionalDetails: p.AdditionalDetails,
+		Price:             p.Price,
+		Currency:          p.Currency,
+		Discount:          p.Discount.toProto(),
+		EffectivePrice:    effectivePrice(p.Price, p.Discount, time.Now()),
+	}
+}
+
+func NewProductManager(client *mongo.Client, dbName, collectionName, priceHistoryCollection string, pgClient *sql.DB) *ProductManager {
+	db := client.Database(dbName)
+	return &ProductManager{
+		Collection:   db.Collection(collectionName),
+		PriceHistory: db.Collection(priceHistoryCollection),
+		PgClient:     pgClient,
+	}
+}
+
+func (m *ProductManager) Create(req *pb.ProductCReq) (*pb.ByID, error) {
+	currency, discount, err := parsePrice(req.Price, req.Currency, req.Discount)
+	if err != nil {
+		return nil, err
+	}
 	product := bson.M{
-		"name":              req.Name,
-		"category":          req.Category,
-		"count":             req.Count,
-		"description":       req.Description,
-		"img_url":           req.ImgUrl,
-		"weight":            req.Weight,
-		"rating":            0,
-		"seller":            req.Seller,
-		"additionalDetails": req.AdditionalDetails,
+		"name":               req.Name,
+		"category":           req.Category,
+		"count":              req.Count,
+		"description":        req.Description,
+		"img_url":            req.ImgUrl,
+		"weight":             req.Weight,
+		"rating":             0,
+		"seller":             req.Seller,
+		"additional_details": req.AdditionalDetails,
+		"price":              req.Price,
+		"currency":           currency,
+		"search":             newSearchDoc(req.Name, req.Category, req.Description, req.AdditionalDetails),
 	}
-	_, err := m.Collection.InsertOne(context.Background(), product)
+	if discount != nil {
+		product["discount"] = discount
+	}
+	res, err := m.Collection.InsertOne(context.Background(), product)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Void{}, nil
+	id := res.InsertedID.(primitive.ObjectID)
+	if err := m.recordPrice(id, req.Price, currency, discount); err != nil {
+		return nil, err
+	}
+	return &pb.ByID{Id: id.Hex()}, nil
 }
 
 func (m *ProductManager) Update(req *pb.ProductUReq) (*pb.Void, error) {
@@ -56,6 +115,7 @@ func (m *ProductManager) Update(req *pb.ProductUReq) (*pb.Void, error) {
 			"weight":             req.Weight,
 			"seller":             req.Seller,
 			"additional_details": req.AdditionalDetails,
+			"search":             newSearchDoc(req.Name, req.Category, req.Description, req.AdditionalDetails),
 		},
 	}
 	_, err = m.Collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
@@ -82,7 +142,7 @@ func (m *ProductManager) Get(req *pb.ByID) (*pb.ProductGRes, error) {
 	if err != nil {
 		return nil, err
 	}
-	var product pb.ProductGRes
+	var product productDoc
 	err = m.Collection.FindOne(context.Background(), bson.M{"_id": id}).Decode(&product)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
@@ -90,45 +150,69 @@ func (m *ProductManager) Get(req *pb.ByID) (*pb.ProductGRes, error) {
 		}
 		return nil, err
 	}
-	return &product, nil
+	return product.toProto(), nil
 }
 
+// GetAll lists products page by page. Pages are addressed with an opaque
+// cursor instead of an offset, so deep pages cost the same as the first one
+// and don't shift when products are added.
 func (m *ProductManager) GetAll(req *pb.ProductGAReq) (*pb.ProductGARes, error) {
-	filter := bson.M{}
-	if req.Category != "" {
-		filter["category"] = req.Category
+	ctx := context.Background()
+	filter, err := productFilter(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.Count != "" {
-		filter["count"] = req.Count
+	sort, err := productSort(req.Sort)
+	if err != nil {
+		return nil, err
 	}
-	if req.Rating != "" {
-		filter["rating"] = req.Rating
+
+	res := &pb.ProductGARes{Products: []*pb.ProductGRes{}}
+	if req.IncludeTotal {
+		if res.TotalCount, err = m.Collection.CountDocuments(ctx, filter); err != nil {
+			return nil, err
+		}
 	}
-	if req.Seller != "" {
-		filter["seller"] = req.Seller
+
+	if req.Cursor != "" {
+		after, err := afterCursor(req.Cursor, req.Sort)
+		if err != nil {
+			return nil, err
+		}
+		filter = bson.M{"$and": bson.A{filter, after}}
 	}
-	options := options.Find()
+	var limit int64
 	if req.Pagination != nil {
-		options.SetSkip(int64((req.Pagination.Offset - 1) * req.Pagination.Limit))
-		options.SetLimit(int64(req.Pagination.Limit))
+		limit = req.Pagination.Limit
 	}
-	cursor, err := m.Collection.Find(context.Background(), filter, options)
+	limit = listLimit(limit)
+
+	// One extra product tells whether there is a next page.
+	cursor, err := m.Collection.Find(ctx, filter, options.Find().SetSort(sort).SetLimit(limit+1))
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
-	var products []*pb.ProductGRes
-	for cursor.Next(context.Background()) {
-		var product pb.ProductGRes
+	defer cursor.Close(ctx)
+	var last productDoc
+	for cursor.Next(ctx) {
+		if int64(len(res.Products)) == limit {
+			res.HasMore = true
+			break
+		}
+		var product productDoc
 		if err := cursor.Decode(&product); err != nil {
 			return nil, err
 		}
-		products = append(products, &product)
+		res.Products = append(res.Products, product.toProto())
+		last = product
 	}
 	if err := cursor.Err(); err != nil {
 		return nil, err
 	}
-	return &pb.ProductGARes{Products: products}, nil
+	if res.HasMore {
+		res.NextCursor = encodeCursor(req.Sort, &last)
+	}
+	return res, nil
 }
 
 func (m *ProductManager) UpdateRating(req *pb.ProductRatingUReq) (*pb.Void, error) {
@@ -146,19 +230,33 @@ func (m *ProductManager) UpdateRating(req *pb.ProductRatingUReq) (*pb.Void, erro
 	return &pb.Void{}, nil
 }
 
+// UpdateCount adds req.Delta to the stock. Reserved units are already taken
+// out of count, so the change is applied on top of them instead of
+// overwriting them, and stock can only be taken away while it isn't reserved.
 func (m *ProductManager) UpdateCount(req *pb.ProductCountUReq) (*pb.Void, error) {
 	id, err := primitive.ObjectIDFromHex(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+	filter := bson.M{"_id": id}
+	if req.Delta < 0 {
+		filter["count"] = bson.M{"$gte": -req.Delta}
+	}
+	res, err := m.Collection.UpdateOne(context.Background(), filter, bson.M{"$inc": bson.M{"count": req.Delta}})
 	if err != nil {
 		return nil, err
 	}
-	update := bson.M{
-		"$set": bson.M{"count": req.Count},
+	if res.MatchedCount > 0 {
+		return &pb.Void{}, nil
 	}
-	_, err = m.Collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
+	count, err := m.Collection.CountDocuments(context.Background(), bson.M{"_id": id})
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Void{}, nil
+	if count == 0 {
+		return nil, status.Errorf(codes.NotFound, "product %s not found", req.ProductId)
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "can't take %v units of product %s, the rest of its stock is reserved or sold", -req.Delta, req.ProductId)
 }
 
 func (m *ProductManager) UpdateImg(req *pb.ProductImageUReq) (*pb.Void, error) {
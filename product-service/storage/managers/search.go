@@ -0,0 +1,268 @@
+package managers
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pb "progress-service/genprotos"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	searchIndexName = "product_search"
+	snippetWords    = 8
+)
+
+// searchDoc holds the normalized copies of the searchable fields. The text
+// index is built over these rather than the originals, so a query typed in
+// either alphabet matches products written in the other one.
+type searchDoc struct {
+	Name        string `bson:"name"`
+	Category    string `bson:"category"`
+	Description string `bson:"description"`
+	Details     string `bson:"details"`
+}
+
+func newSearchDoc(name, category, description string, details map[string]string) searchDoc {
+	values := make([]string, 0, len(details))
+	for _, v := range details {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return searchDoc{
+		Name:        normalize(name),
+		Category:    normalize(category),
+		Description: normalize(description),
+		Details:     normalize(strings.Join(values, " ")),
+	}
+}
+
+// EnsureSearchIndex creates the weighted text index and fills in the
+// normalized search fields of products created before search existed.
+func (m *ProductManager) EnsureSearchIndex() error {
+	ctx := context.Background()
+	_, err := m.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "search.name", Value: "text"},
+			{Key: "search.category", Value: "text"},
+			{Key: "search.description", Value: "text"},
+			{Key: "search.details", Value: "text"},
+		},
+		Options: options.Index().
+			SetName(searchIndexName).
+			// Mongo has no Uzbek stemmer; "none" keeps words as they are.
+			SetDefaultLanguage("none").
+			SetWeights(bson.D{
+				{Key: "search.name", Value: 10},
+				{Key: "search.category", Value: 5},
+				{Key: "search.description", Value: 2},
+				{Key: "search.details", Value: 1},
+			}),
+	})
+	if err != nil {
+		return err
+	}
+
+	cursor, err := m.Collection.Find(ctx, bson.M{"search": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var product productDoc
+		if err := cursor.Decode(&product); err != nil {
+			return err
+		}
+		search := newSearchDoc(product.Name, product.Category, product.Description, product.AdditionalDetails)
+		if _, err := m.Collection.UpdateOne(ctx, bson.M{"_id": product.ID}, bson.M{"$set": bson.M{"search": search}}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+func (m *ProductManager) Search(req *pb.SearchReq) (*pb.SearchRes, error) {
+	query := normalize(strings.TrimSpace(req.Query))
+	terms := words(query)
+	if len(terms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "search query is required")
+	}
+	ctx := context.Background()
+
+	textFilter := bson.M{"$text": bson.M{"$search": strings.Join(terms, " ")}}
+	filter := bson.M{"$text": textFilter["$text"]}
+	if req.Category != "" {
+		filter["category"] = req.Category
+	}
+	if req.Seller != "" {
+		filter["seller"] = req.Seller
+	}
+
+	total, err := m.Collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int64
+	if req.Pagination != nil {
+		limit = req.Pagination.Limit
+		if req.Pagination.Offset > 0 {
+			offset = req.Pagination.Offset
+		}
+	}
+	limit = listLimit(limit)
+	score := bson.M{"$meta": "textScore"}
+	cursor, err := m.Collection.Find(ctx, filter, options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}}).
+		SetSkip(offset).
+		SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	res := &pb.SearchRes{Hits: []*pb.SearchHit{}, Total: total}
+	for cursor.Next(ctx) {
+		var hit struct {
+			Product productDoc `bson:",inline"`
+			Score   float64    `bson:"score"`
+		}
+		if err := cursor.Decode(&hit); err != nil {
+			return nil, err
+		}
+		res.Hits = append(res.Hits, &pb.SearchHit{
+			Product:    hit.Product.toProto(),
+			Score:      hit.Score,
+			Highlights: highlights(&hit.Product, terms),
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	// Facets ignore the category and seller filters, so the client can show
+	// how many results every other category or seller would give.
+	res.Categories, res.Sellers, err = m.facets(ctx, textFilter)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *ProductManager) facets(ctx context.Context, filter bson.M) ([]*pb.FacetCount, []*pb.FacetCount, error) {
+	countBy := func(field string) bson.A {
+		return bson.A{
+			bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+	cursor, err := m.Collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: bson.M{
+			"categories": countBy("category"),
+			"sellers":    countBy("seller"),
+		}}},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	type bucket struct {
+		Value string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	var result []struct {
+		Categories []bucket `bson:"categories"`
+		Sellers    []bucket `bson:"sellers"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, nil, err
+	}
+	toProto := func(buckets []bucket) []*pb.FacetCount {
+		res := make([]*pb.FacetCount, 0, len(buckets))
+		for _, b := range buckets {
+			res = append(res, &pb.FacetCount{Value: b.Value, Count: b.Count})
+		}
+		return res
+	}
+	if len(result) == 0 {
+		return []*pb.FacetCount{}, []*pb.FacetCount{}, nil
+	}
+	return toProto(result[0].Categories), toProto(result[0].Sellers), nil
+}
+
+// highlights returns, for every field that matched, a snippet of the original
+// text around the first match with matching words wrapped in <em>.
+func highlights(p *productDoc, terms []string) map[string]string {
+	fields := map[string]string{
+		"name":        p.Name,
+		"category":    p.Category,
+		"description": p.Description,
+	}
+	for key, value := range p.AdditionalDetails {
+		fields["additional_details."+key] = value
+	}
+
+	res := map[string]string{}
+	for field, text := range fields {
+		if snippet, ok := snippet(text, terms); ok {
+			res[field] = snippet
+		}
+	}
+	return res
+}
+
+func snippet(text string, terms []string) (string, bool) {
+	tokens := strings.Fields(text)
+	first := -1
+	marked := make([]string, len(tokens))
+	for i, token := range tokens {
+		marked[i] = token
+		if matchesAny(token, terms) {
+			marked[i] = "<em>" + token + "</em>"
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+
+	start, end := first-snippetWords, first+snippetWords+1
+	if start < 0 {
+		start = 0
+	}
+	if end > len(marked) {
+		end = len(marked)
+	}
+	out := strings.Join(marked[start:end], " ")
+	if start > 0 {
+		out = "…" + out
+	}
+	if end < len(marked) {
+		out += "…"
+	}
+	return out, true
+}
+
+// matchesAny compares a token of the original text with normalized query
+// terms, the same way the text index compares whole words.
+func matchesAny(token string, terms []string) bool {
+	for _, word := range words(normalize(token)) {
+		for _, term := range terms {
+			if word == term {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,369 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "progress-service/genprotos"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ReservationReserving = "reserving"
+	ReservationReserved  = "reserved"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+	ReservationReturned  = "returned"
+)
+
+// StockManager keeps product counts consistent under concurrent checkouts.
+// Stock is taken out of "count" when it is reserved; a reservation is then
+// either committed (the stock is sold) or released/expired (the stock is
+// returned). Sold stock is returned too if its order is cancelled or fails.
+// Every change of count is a conditional update, so count never goes below
+// zero.
+type StockManager struct {
+	Products     *mongo.Collection
+	Reservations *mongo.Collection
+}
+
+type stockItem struct {
+	ProductID primitive.ObjectID `bson:"product_id"`
+	Quantity  int64              `bson:"quantity"`
+}
+
+// A reservation whose stock is being given back has Restocking set until every
+// item is, and lists the products already given back in Restocked.
+type reservationDoc struct {
+	ID         primitive.ObjectID   `bson:"_id,omitempty"`
+	Status     string               `bson:"status"`
+	Items      []stockItem          `bson:"items"`
+	ExpiresAt  time.Time            `bson:"expires_at"`
+	CreatedAt  time.Time            `bson:"created_at"`
+	Restocking bool                 `bson:"restocking,omitempty"`
+	Restocked  []primitive.ObjectID `bson:"restocked,omitempty"`
+}
+
+func (r *reservationDoc) toProto() *pb.ReservationRes {
+	res := &pb.ReservationRes{
+		ReservationId: r.ID.Hex(),
+		Status:        r.Status,
+		Items:         []*pb.StockReq{},
+		ExpiresAt:     r.ExpiresAt.Format(time.RFC3339),
+	}
+	for _, item := range r.Items {
+		res.Items = append(res.Items, &pb.StockReq{ProductId: item.ProductID.Hex(), Quantity: item.Quantity})
+	}
+	return res
+}
+
+func NewStockManager(client *mongo.Client, dbName, productCollection, reservationCollection string) *StockManager {
+	db := client.Database(dbName)
+	return &StockManager{
+		Products:     db.Collection(productCollection),
+		Reservations: db.Collection(reservationCollection),
+	}
+}
+
+// Reserve takes every item out of stock or none of them. Items are decremented
+// one by one and recorded on the reservation as they succeed, so a failure
+// part way through (or a crash, via expiry) gives back exactly what was taken.
+func (m *StockManager) Reserve(items []*pb.StockReq, ttl time.Duration) (*pb.ReservationRes, error) {
+	merged, err := mergeItems(items)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+
+	now := time.Now()
+	reservation := reservationDoc{
+		ID:        primitive.NewObjectID(),
+		Status:    ReservationReserving,
+		Items:     []stockItem{},
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+	if _, err := m.Reservations.InsertOne(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	for _, item := range merged {
+		if err := m.decrement(item); err != nil {
+			if _, releaseErr := m.Release(&pb.ReservationReq{ReservationId: reservation.ID.Hex()}); releaseErr != nil {
+				return nil, status.Errorf(codes.Internal, "%s; also failed to roll back reservation: %s", err.Error(), releaseErr.Error())
+			}
+			return nil, err
+		}
+		res, err := m.Reservations.UpdateOne(ctx,
+			bson.M{"_id": reservation.ID, "status": ReservationReserving},
+			bson.M{"$push": bson.M{"items": item}})
+		if err == nil && res.MatchedCount == 0 {
+			err = status.Error(codes.Aborted, "reservation expired while it was being made")
+		}
+		if err != nil {
+			// The item is not on the reservation, so nothing else will return it.
+			m.increment(item)
+			m.Release(&pb.ReservationReq{ReservationId: reservation.ID.Hex()})
+			return nil, err
+		}
+		reservation.Items = append(reservation.Items, item)
+	}
+
+	res, err := m.Reservations.UpdateOne(ctx,
+		bson.M{"_id": reservation.ID, "status": ReservationReserving},
+		bson.M{"$set": bson.M{"status": ReservationReserved}})
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.Aborted, "reservation expired while it was being made")
+	}
+	reservation.Status = ReservationReserved
+	return reservation.toProto(), nil
+}
+
+// Release returns the reserved stock. Releasing a reservation that is already
+// released or expired is a no-op, releasing a committed one is an error.
+func (m *StockManager) Release(req *pb.ReservationReq) (*pb.Void, error) {
+	reservation, err := m.transition(req.ReservationId,
+		bson.M{"status": bson.M{"$in": bson.A{ReservationReserving, ReservationReserved}}},
+		bson.M{"status": ReservationReleased, "restocking": true})
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		current, err := m.get(req.ReservationId)
+		if err != nil {
+			return nil, err
+		}
+		if current.Status == ReservationCommitted {
+			return nil, status.Error(codes.FailedPrecondition, "reservation is already committed")
+		}
+		return &pb.Void{}, nil
+	}
+	return &pb.Void{}, m.restock(reservation)
+}
+
+// Commit makes the reservation final, so the stock it holds is sold and will
+// not be given back. An expired reservation can't be committed.
+func (m *StockManager) Commit(req *pb.ReservationReq) (*pb.Void, error) {
+	reservation, err := m.transition(req.ReservationId,
+		bson.M{"status": ReservationReserved, "expires_at": bson.M{"$gt": time.Now()}},
+		bson.M{"status": ReservationCommitted})
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		current, err := m.get(req.ReservationId)
+		if err != nil {
+			return nil, err
+		}
+		if current.Status == ReservationCommitted {
+			return &pb.Void{}, nil
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "reservation can't be committed, it is %s", statusOrExpired(current))
+	}
+	return &pb.Void{}, nil
+}
+
+// Return gives back the stock a reservation holds, whether it is still
+// reserved or already committed. Returning it twice is a no-op.
+func (m *StockManager) Return(req *pb.ReservationReq) (*pb.Void, error) {
+	reservation, err := m.transition(req.ReservationId,
+		bson.M{"status": ReservationCommitted},
+		bson.M{"status": ReservationReturned, "restocking": true})
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return m.Release(req)
+	}
+	return &pb.Void{}, m.restock(reservation)
+}
+
+// ExpireReservations gives back the stock of every reservation that was
+// neither committed nor released before it expired, and finishes giving back
+// stock that failed part way before.
+func (m *StockManager) ExpireReservations() (int, error) {
+	if err := m.resumeRestocks(); err != nil {
+		return 0, err
+	}
+	expired := 0
+	for {
+		var reservation reservationDoc
+		err := m.Reservations.FindOneAndUpdate(context.Background(),
+			bson.M{
+				"status":     bson.M{"$in": bson.A{ReservationReserving, ReservationReserved}},
+				"expires_at": bson.M{"$lte": time.Now()},
+			},
+			bson.M{"$set": bson.M{"status": ReservationExpired, "restocking": true}}).Decode(&reservation)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return expired, nil
+		}
+		if err != nil {
+			return expired, err
+		}
+		if err := m.restock(&reservation); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+}
+
+// resumeRestocks gives back the rest of the stock of reservations a failure
+// left restocking.
+func (m *StockManager) resumeRestocks() error {
+	cursor, err := m.Reservations.Find(context.Background(), bson.M{"restocking": true})
+	if err != nil {
+		return err
+	}
+	var reservations []reservationDoc
+	if err := cursor.All(context.Background(), &reservations); err != nil {
+		return err
+	}
+	for i := range reservations {
+		if err := m.restock(&reservations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transition applies set to a reservation matching filter and returns it as it
+// was before, or nil if nothing matched.
+func (m *StockManager) transition(reservationID string, filter bson.M, set bson.M) (*reservationDoc, error) {
+	id, err := primitive.ObjectIDFromHex(reservationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reservation id")
+	}
+	filter["_id"] = id
+	var reservation reservationDoc
+	err = m.Reservations.FindOneAndUpdate(context.Background(), filter,
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before)).Decode(&reservation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (m *StockManager) get(reservationID string) (*reservationDoc, error) {
+	id, err := primitive.ObjectIDFromHex(reservationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reservation id")
+	}
+	var reservation reservationDoc
+	err = m.Reservations.FindOne(context.Background(), bson.M{"_id": id}).Decode(&reservation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, status.Error(codes.NotFound, "reservation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// restock gives back the items of a reservation marked restocking. The mark
+// goes with the status change in one update, so a failure here leaves the
+// reservation for resumeRestocks instead of losing its stock. Each product is
+// added to Restocked before its count goes up, so no retry gives it back
+// twice, and taken off again if the count can't be changed.
+func (m *StockManager) restock(reservation *reservationDoc) error {
+	ctx := context.Background()
+	for _, item := range reservation.Items {
+		res, err := m.Reservations.UpdateOne(ctx,
+			bson.M{"_id": reservation.ID, "restocked": bson.M{"$ne": item.ProductID}},
+			bson.M{"$push": bson.M{"restocked": item.ProductID}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			continue
+		}
+		if err := m.increment(item); err != nil {
+			m.Reservations.UpdateOne(ctx,
+				bson.M{"_id": reservation.ID},
+				bson.M{"$pull": bson.M{"restocked": item.ProductID}})
+			return err
+		}
+	}
+	_, err := m.Reservations.UpdateOne(ctx,
+		bson.M{"_id": reservation.ID},
+		bson.M{"$unset": bson.M{"restocking": ""}})
+	return err
+}
+
+// decrement takes quantity items out of stock only if that many are left, so
+// two buyers can never both get the last unit.
+func (m *StockManager) decrement(item stockItem) error {
+	res, err := m.Products.UpdateOne(context.Background(),
+		bson.M{"_id": item.ProductID, "count": bson.M{"$gte": item.Quantity}},
+		bson.M{"$inc": bson.M{"count": -item.Quantity}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	count, err := m.Products.CountDocuments(context.Background(), bson.M{"_id": item.ProductID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return status.Errorf(codes.NotFound, "product %s not found", item.ProductID.Hex())
+	}
+	return status.Errorf(codes.FailedPrecondition, "insufficient stock for product %s", item.ProductID.Hex())
+}
+
+// increment puts items back into stock. A product deleted in the meantime has
+// no stock to return to, so it is skipped.
+func (m *StockManager) increment(item stockItem) error {
+	_, err := m.Products.UpdateOne(context.Background(),
+		bson.M{"_id": item.ProductID},
+		bson.M{"$inc": bson.M{"count": item.Quantity}})
+	return err
+}
+
+// mergeItems validates items and sums quantities of repeated products, so a
+// product listed twice is checked against its stock once.
+func mergeItems(items []*pb.StockReq) ([]stockItem, error) {
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no items to reserve")
+	}
+	merged := []stockItem{}
+	index := map[primitive.ObjectID]int{}
+	for _, item := range items {
+		id, err := primitive.ObjectIDFromHex(item.ProductId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product id %q", item.ProductId)
+		}
+		if item.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+		}
+		if i, ok := index[id]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[id] = len(merged)
+		merged = append(merged, stockItem{ProductID: id, Quantity: item.Quantity})
+	}
+	return merged, nil
+}
+
+func statusOrExpired(r *reservationDoc) string {
+	if r.Status == ReservationReserved && !r.ExpiresAt.After(time.Now()) {
+		return ReservationExpired
+	}
+	return r.Status
+}
@@ -0,0 +1,48 @@
+package managers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// cyrillicToLatin follows the official Uzbek Latin alphabet. Russian letters
+// that Uzbek Cyrillic borrows (ц, щ, ъ, ь, ы) are mapped to their usual
+// spelling in Latin-script Uzbek.
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "j", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "x", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sh", 'ъ': "",
+	'ы': "i", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'ў': "o", 'қ': "q",
+	'ғ': "g", 'ҳ': "h",
+}
+
+// apostrophes are the many ways people type the mark in o‘ and g‘. They are
+// dropped, so "o'zbek", "oʻzbek" and "ozbek" all normalize to the same word.
+const apostrophes = "'`’‘ʻʼ"
+
+// normalize lowercases s and rewrites it in Latin script without apostrophes,
+// so that text typed in either Uzbek alphabet compares equal.
+func normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range strings.ToLower(s) {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		if strings.ContainsRune(apostrophes, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// words splits s into words the way the text index does, keeping the
+// characters letters and digits are made of.
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune(apostrophes, r)
+	})
+}
@@ -18,6 +18,7 @@ type Storage struct {
 	MongoClient *mongo.Client
 
 	ProductS ProductI
+	StockS   StockI
 }
 
 func NewPostgresStorage(config config.Config) (*Storage, error) {
@@ -44,17 +45,36 @@ func NewPostgresStorage(config config.Config) (*Storage, error) {
 	}
 	fmt.Println("Successfully connected to the database mongodb!!!")
 
-	pm := managers.NewProductManager(client, config.MONGO_DB_NAME, config.MONGO_COLLECTION_NAME, db)
+	pm := managers.NewProductManager(client, config.MONGO_DB_NAME, config.MONGO_COLLECTION_NAME, config.MONGO_PRICE_HISTORY_COLLECTION_NAME, db)
+	if err = pm.EnsureSearchIndex(); err != nil {
+		return nil, err
+	}
+	if err = pm.BackfillSortKeys(); err != nil {
+		return nil, err
+	}
+
+	sm := managers.NewStockManager(client, config.MONGO_DB_NAME, config.MONGO_COLLECTION_NAME, config.MONGO_RESERVATION_COLLECTION_NAME)
 
 	return &Storage{
-		PgClient: db,
-		ProductS: pm,
+		PgClient:    db,
+		MongoClient: client,
+		ProductS:    pm,
+		StockS:      sm,
 	}, nil
 }
 
 func (s *Storage) Product() ProductI {
 	if s.ProductS == nil {
-		s.ProductS = managers.NewProductManager(s.MongoClient, config.Load().MONGO_DB_NAME, config.Load().MONGO_COLLECTION_NAME, s.PgClient)
+		cf := config.Load()
+		s.ProductS = managers.NewProductManager(s.MongoClient, cf.MONGO_DB_NAME, cf.MONGO_COLLECTION_NAME, cf.MONGO_PRICE_HISTORY_COLLECTION_NAME, s.PgClient)
 	}
 	return s.ProductS
 }
+
+func (s *Storage) Stock() StockI {
+	if s.StockS == nil {
+		cf := config.Load()
+		s.StockS = managers.NewStockManager(s.MongoClient, cf.MONGO_DB_NAME, cf.MONGO_COLLECTION_NAME, cf.MONGO_RESERVATION_COLLECTION_NAME)
+	}
+	return s.StockS
+}
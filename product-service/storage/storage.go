@@ -1,19 +1,33 @@
 package storage
 
 import (
+	"time"
+
 	pb "progress-service/genprotos"
 )
 
 type StorageI interface {
 	Product() ProductI
+	Stock() StockI
 }
 
 type ProductI interface {
-	Create(*pb.ProductCReq) (*pb.Void, error)
+	Create(*pb.ProductCReq) (*pb.ByID, error)
 	Update(*pb.ProductUReq) (*pb.Void, error)
 	Delete(*pb.ByID) (*pb.Void, error)
 	Get(*pb.ByID) (*pb.ProductGRes, error)
 	GetAll(*pb.ProductGAReq) (*pb.ProductGARes, error)
+	Search(*pb.SearchReq) (*pb.SearchRes, error)
 	UpdateRating(*pb.ProductRatingUReq) (*pb.Void, error)
 	UpdateCount(*pb.ProductCountUReq) (*pb.Void, error)
+	UpdatePrice(*pb.ProductPriceUReq) (*pb.Void, error)
+	GetPriceHistory(*pb.PriceHistoryReq) (*pb.PriceHistoryRes, error)
+}
+
+type StockI interface {
+	Reserve(items []*pb.StockReq, ttl time.Duration) (*pb.ReservationRes, error)
+	Release(*pb.ReservationReq) (*pb.Void, error)
+	Commit(*pb.ReservationReq) (*pb.Void, error)
+	Return(*pb.ReservationReq) (*pb.Void, error)
+	ExpireReservations() (int, error)
 }